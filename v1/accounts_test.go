@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -266,6 +267,144 @@ func TestListAccountsPagination(t *testing.T) {
 	assert.False(t, hasMore)
 }
 
+func TestListAccountsStableOrder(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	var facebook, twitter []v1.Account
+	for i := 0; i < 8; i++ {
+		facebook = append(facebook, v1.Account{
+			ID:       fmt.Sprintf("account-%02d", i*2+1),
+			Name:     fmt.Sprintf("Facebook %d", i+1),
+			Provider: "facebook",
+		})
+		twitter = append(twitter, v1.Account{
+			ID:       fmt.Sprintf("account-%02d", i*2+2),
+			Name:     fmt.Sprintf("Twitter %d", i+1),
+			Provider: "twitter",
+		})
+	}
+
+	server.Reset()
+	server.AddAccounts(facebook)
+	server.AddAccounts(twitter)
+
+	iterator := client.ListAccounts(context.Background(), v1.ListAccountsRequest{})
+
+	var page1 v1.Page[v1.Account]
+	hasMore := iterator.Next(context.Background(), &page1)
+	require.NoError(t, iterator.Err())
+	require.True(t, hasMore)
+
+	// Reorder the backing accounts between page fetches
+	server.SetAccountsByProvider("facebook", facebook)
+
+	var page2 v1.Page[v1.Account]
+	hasMore = iterator.Next(context.Background(), &page2)
+	require.NoError(t, iterator.Err())
+	assert.False(t, hasMore)
+
+	seen := make(map[string]bool)
+	var ids []string
+	for _, account := range append(page1.Items, page2.Items...) {
+		assert.False(t, seen[account.ID])
+		seen[account.ID] = true
+		ids = append(ids, account.ID)
+	}
+
+	require.Len(t, ids, 16)
+	for i, id := range ids {
+		assert.Equal(t, fmt.Sprintf("account-%02d", i+1), id)
+	}
+}
+
+func TestListAccountsPage(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	var accounts []v1.Account
+	for i := 1; i <= 25; i++ {
+		accounts = append(accounts, v1.Account{
+			ID:       fmt.Sprintf("account-%02d", i),
+			Name:     fmt.Sprintf("Account %d", i),
+			Provider: "facebook",
+		})
+	}
+
+	server.Reset()
+	server.AddAccounts(accounts)
+
+	page, err := client.ListAccountsPage(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, 25, page.Total)
+	assert.Equal(t, 2, page.Page)
+	assert.Equal(t, 10, page.PerPage)
+	assert.Equal(t, 3, page.TotalPages)
+	require.Len(t, page.Items, 10)
+	assert.Equal(t, "account-11", page.Items[0].ID)
+	assert.Equal(t, "account-20", page.Items[9].ID)
+
+	page, err = client.ListAccountsPage(context.Background(), 3)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 5)
+	assert.Equal(t, "account-21", page.Items[0].ID)
+
+	_, err = client.ListAccountsPage(context.Background(), 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "page must be at least 1")
+}
+
+func TestMyPostableAccounts(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	var accounts []v1.Account
+	for i := 1; i <= 15; i++ {
+		accounts = append(accounts, v1.Account{
+			ID:       fmt.Sprintf("account-%02d", i),
+			Provider: "facebook",
+		})
+	}
+
+	server.Reset()
+	server.AddAccounts(accounts)
+	server.SetAccountPermissions("account-02", []string{"read", v1.AccountPermissionPost})
+	server.SetAccountPermissions("account-05", []string{"read"})
+	server.SetAccountPermissions("account-12", []string{v1.AccountPermissionPost})
+	server.SetAccountPermissions("account-14", []string{"read", "analytics"})
+
+	postable, err := client.MyPostableAccounts(context.Background())
+	require.NoError(t, err)
+
+	var ids []string
+	for _, account := range postable {
+		ids = append(ids, account.ID)
+	}
+	assert.Equal(t, []string{"account-02", "account-12"}, ids)
+}
+
+func TestMyPostableAccountsNone(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddAccounts([]v1.Account{
+		{ID: "account-1", Provider: "twitter", Permissions: []string{"read"}},
+	})
+
+	postable, err := client.MyPostableAccounts(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, postable)
+}
+
 func TestListAccountsContextCancellation(t *testing.T) {
 	server := v1.SpawnMockServer()
 	defer func() { _ = server.Stop() }()
@@ -294,4 +433,180 @@ func TestListAccountsContextCancellation(t *testing.T) {
 
 	assert.False(t, hasMore)
 	require.ErrorContains(t, iterator.Err(), "context canceled")
-}
\ No newline at end of file
+}
+
+func TestAccountCountsByProvider(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	var accounts []v1.Account
+	for i, provider := range []string{"facebook", "twitter", "twitter", "linkedin", "linkedin", "linkedin"} {
+		accounts = append(accounts, v1.Account{
+			ID:       fmt.Sprintf("account-%03d", i+1),
+			Name:     "Account",
+			Provider: provider,
+		})
+	}
+
+	t.Run("AllAccounts", func(t *testing.T) {
+		server.Reset()
+		server.AddAccounts(accounts)
+
+		counts, err := client.AccountCountsByProvider(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"facebook": 1, "twitter": 2, "linkedin": 3}, counts)
+	})
+
+	t.Run("NoAccounts", func(t *testing.T) {
+		server.Reset()
+
+		counts, err := client.AccountCountsByProvider(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, counts)
+	})
+}
+
+func TestAccountQuota(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetCurrentUser(v1.User{ID: "user-1", Email: "owner@example.com"})
+	server.SetAccountLimit(5)
+
+	var accounts []v1.Account
+	for i := 1; i <= 3; i++ {
+		accounts = append(accounts, v1.Account{ID: fmt.Sprintf("account-%02d", i), Provider: "twitter"})
+	}
+	server.AddAccounts(accounts)
+
+	used, limit, err := client.AccountQuota(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, used)
+	assert.Equal(t, 5, limit)
+}
+
+func TestAccountQuotaUnlimited(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetCurrentUser(v1.User{ID: "user-1", Email: "owner@example.com"})
+	server.AddAccounts([]v1.Account{{ID: "account-01", Provider: "twitter"}})
+
+	used, limit, err := client.AccountQuota(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, used)
+	assert.Equal(t, 0, limit)
+}
+
+func TestBestTimes(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddAccounts([]v1.Account{{ID: "acc-001", Provider: "twitter", Name: "Twitter"}})
+	server.SetBestTimes("acc-001", []v1.TimeSlot{
+		{Weekday: time.Monday, Time: "09:00", Score: 0.92},
+		{Weekday: time.Wednesday, Time: "18:30", Score: 0.81},
+	})
+
+	slots, err := client.BestTimes(context.Background(), "acc-001")
+	require.NoError(t, err)
+	require.Len(t, slots, 2)
+	assert.Equal(t, time.Monday, slots[0].Weekday)
+	assert.Equal(t, "09:00", slots[0].Time)
+	assert.Equal(t, 0.92, slots[0].Score)
+	assert.Equal(t, time.Wednesday, slots[1].Weekday)
+	assert.Equal(t, "18:30", slots[1].Time)
+}
+
+func TestBestTimesErrors(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddAccounts([]v1.Account{{ID: "acc-001", Provider: "twitter", Name: "Twitter"}})
+
+	// A known account without seeded slots has no recommendations
+	slots, err := client.BestTimes(context.Background(), "acc-001")
+	require.NoError(t, err)
+	assert.Empty(t, slots)
+
+	_, err = client.BestTimes(context.Background(), "acc-999")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, v1.ErrNotFound)
+
+	_, err = client.BestTimes(context.Background(), "../admin")
+	require.Error(t, err)
+	require.ErrorContains(t, err, "invalid account ID")
+
+	_, err = client.BestTimes(context.Background(), "")
+	require.Error(t, err)
+	require.ErrorContains(t, err, "account ID cannot be empty")
+}
+
+func TestGetAccountReconnectURL(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddAccounts([]v1.Account{
+		{ID: "acc-001", Provider: "twitter", Name: "Twitter", State: v1.AccountStateActive},
+	})
+
+	// Active accounts cannot be reconnected
+	_, err := client.GetAccountReconnectURL(context.Background(), "acc-001")
+	require.Error(t, err)
+	var apiErr *v1.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 409, apiErr.StatusCode)
+	assert.Equal(t, "account_active", apiErr.Code)
+
+	server.SetAccountState("acc-001", v1.AccountStateExpired)
+
+	accounts, err := client.ListAccountsPage(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, accounts.Items, 1)
+	assert.Equal(t, v1.AccountStateExpired, accounts.Items[0].State)
+
+	url, err := client.GetAccountReconnectURL(context.Background(), "acc-001")
+	require.NoError(t, err)
+	assert.Equal(t, "https://app.publer.com/accounts/acc-001/reconnect", url)
+
+	// Once reconnected the account is active again
+	server.SetAccountState("acc-001", v1.AccountStateActive)
+	_, err = client.GetAccountReconnectURL(context.Background(), "acc-001")
+	require.Error(t, err)
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 409, apiErr.StatusCode)
+}
+
+func TestGetAccountReconnectURLErrors(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	_, err := client.GetAccountReconnectURL(context.Background(), "acc-999")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, v1.ErrNotFound)
+
+	_, err = client.GetAccountReconnectURL(context.Background(), "../admin")
+	require.Error(t, err)
+	require.ErrorContains(t, err, "invalid account ID")
+}
@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// icalTimeFormat is the RFC 5545 UTC date-time format
+const icalTimeFormat = "20060102T150405Z"
+
+// calendarWriter writes an RFC 5545 iCalendar stream, remembering the first write error
+type calendarWriter struct {
+	w   io.Writer
+	err error
+}
+
+// line writes a content line, folding it at 75 octets as required by RFC 5545
+func (cw *calendarWriter) line(name, value string) {
+	if cw.err != nil {
+		return
+	}
+
+	content := name + ":" + value
+	var b strings.Builder
+	limit := 75
+	for len(content) > limit {
+		cut := limit
+		// Avoid splitting a multi-byte UTF-8 sequence
+		for cut > 0 && content[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(content[:cut])
+		b.WriteString("\r\n ")
+		content = content[cut:]
+		// Continuation lines start with a space which counts towards the limit
+		limit = 74
+	}
+	b.WriteString(content)
+	b.WriteString("\r\n")
+
+	_, cw.err = io.WriteString(cw.w, b.String())
+}
+
+// event writes a VEVENT for a scheduled post
+func (cw *calendarWriter) event(post Post, stamp time.Time) {
+	cw.line("BEGIN", "VEVENT")
+	cw.line("UID", post.ID+"@publer")
+	cw.line("DTSTAMP", stamp.UTC().Format(icalTimeFormat))
+	cw.line("DTSTART", post.ScheduledAt.UTC().Format(icalTimeFormat))
+	cw.line("SUMMARY", escapeCalendarText(calendarSummary(post)))
+	cw.line("DESCRIPTION", escapeCalendarText(post.Text))
+	cw.line("END", "VEVENT")
+}
+
+// calendarSummary returns a short title for a post based on its first line of text
+func calendarSummary(post Post) string {
+	summary, _, _ := strings.Cut(post.Text, "\n")
+	if runes := []rune(summary); len(runes) > 60 {
+		summary = string(runes[:60]) + "..."
+	}
+	if summary == "" {
+		summary = fmt.Sprintf("Post %s", post.ID)
+	}
+	if post.Network != "" {
+		summary = fmt.Sprintf("[%s] %s", post.Network, summary)
+	}
+	return summary
+}
+
+// escapeCalendarText escapes a TEXT value as described in RFC 5545 section 3.3.11
+func escapeCalendarText(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(text)
+}
@@ -0,0 +1,210 @@
+package v1_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/thrawn/publer.go/v1"
+)
+
+// parseEvents unfolds an iCalendar stream and returns the properties of each VEVENT
+func parseEvents(t *testing.T, data string) []map[string]string {
+	require.True(t, strings.HasPrefix(data, "BEGIN:VCALENDAR\r\n"))
+	require.True(t, strings.HasSuffix(data, "END:VCALENDAR\r\n"))
+
+	unfolded := strings.ReplaceAll(data, "\r\n ", "")
+
+	var events []map[string]string
+	var current map[string]string
+	for _, line := range strings.Split(strings.TrimSuffix(unfolded, "\r\n"), "\r\n") {
+		name, value, ok := strings.Cut(line, ":")
+		require.True(t, ok)
+		switch {
+		case line == "BEGIN:VEVENT":
+			current = make(map[string]string)
+		case line == "END:VEVENT":
+			events = append(events, current)
+			current = nil
+		case current != nil:
+			current[name] = value
+		}
+	}
+	return events
+}
+
+func TestExportCalendar(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{
+			ID:          "post-01",
+			Text:        "Launch day!\nJoin us, everyone; it's here",
+			State:       "scheduled",
+			Network:     "twitter",
+			ScheduledAt: time.Date(2024, 7, 4, 9, 30, 0, 0, newYork),
+		},
+		{
+			ID:          "post-02",
+			Text:        strings.Repeat("A long post about features. ", 10),
+			State:       "scheduled",
+			ScheduledAt: time.Date(2024, 7, 5, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          "post-03",
+			Text:        "Already out",
+			State:       "published",
+			ScheduledAt: time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC),
+		},
+	})
+
+	var buf bytes.Buffer
+	err = client.ExportCalendar(context.Background(), v1.ListPostsRequest{}, &buf)
+	require.NoError(t, err)
+
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+
+	events := parseEvents(t, buf.String())
+	require.Len(t, events, 2)
+
+	assert.Equal(t, "post-01@publer", events[0]["UID"])
+	assert.Equal(t, "20240704T133000Z", events[0]["DTSTART"])
+	assert.Equal(t, "[twitter] Launch day!", events[0]["SUMMARY"])
+	assert.Equal(t, `Launch day!\nJoin us\, everyone\; it's here`, events[0]["DESCRIPTION"])
+
+	assert.Equal(t, "post-02@publer", events[1]["UID"])
+	assert.Equal(t, "20240705T180000Z", events[1]["DTSTART"])
+	assert.Equal(t, strings.Repeat("A long post about features. ", 10), strings.ReplaceAll(events[1]["DESCRIPTION"], `\`, ""))
+}
+
+func TestExportCalendarPaging(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	start := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+	var posts []v1.Post
+	for i := 0; i < 25; i++ {
+		posts = append(posts, v1.Post{
+			ID:          fmt.Sprintf("post-%02d", i),
+			Text:        fmt.Sprintf("Post %d", i),
+			State:       "scheduled",
+			ScheduledAt: start.Add(time.Duration(i) * time.Hour),
+		})
+	}
+
+	server.Reset()
+	server.AddPosts(posts)
+
+	var buf bytes.Buffer
+	err := client.ExportCalendar(context.Background(), v1.ListPostsRequest{}, &buf)
+	require.NoError(t, err)
+
+	events := parseEvents(t, buf.String())
+	require.Len(t, events, 25)
+	for i, event := range events {
+		assert.Equal(t, start.Add(time.Duration(i)*time.Hour).Format("20060102T150405Z"), event["DTSTART"])
+	}
+}
+
+func TestExportCalendarError(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetErrorResponse("GET", "/api/v1/posts", 0, 500, v1.ErrorResponse{Error: "Internal Server Error"}, nil)
+
+	var buf bytes.Buffer
+	err := client.ExportCalendar(context.Background(), v1.ListPostsRequest{}, &buf)
+	require.Error(t, err)
+}
+
+func TestCalendarGrid(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	day := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{ID: "post-01", State: "scheduled", ScheduledAt: day.Add(9*time.Hour + 5*time.Minute)},
+		{ID: "post-02", State: "scheduled", ScheduledAt: day.Add(9*time.Hour + 55*time.Minute)},
+		{ID: "post-03", State: "scheduled", ScheduledAt: day.Add(11*time.Hour + 30*time.Minute)},
+		{ID: "post-04", State: "scheduled", ScheduledAt: day.Add(26 * time.Hour)},
+		{ID: "post-05", State: "published", ScheduledAt: day.Add(9 * time.Hour)},
+		{ID: "post-06", State: "scheduled", ScheduledAt: day.Add(7 * 24 * time.Hour)},
+	})
+
+	grid, err := client.CalendarGrid(context.Background(), day, day.Add(7*24*time.Hour), time.Hour)
+	require.NoError(t, err)
+
+	ids := make(map[time.Time][]string)
+	for slot, posts := range grid {
+		for _, post := range posts {
+			ids[slot] = append(ids[slot], post.ID)
+		}
+	}
+	assert.Equal(t, map[time.Time][]string{
+		day.Add(9 * time.Hour):  {"post-01", "post-02"},
+		day.Add(11 * time.Hour): {"post-03"},
+		day.Add(26 * time.Hour): {"post-04"},
+	}, ids)
+}
+
+func TestCalendarGridTimeZone(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	// Hourly slots follow the post's wall clock, not UTC, for a +05:30 offset
+	india := time.FixedZone("IST", 5*3600+1800)
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{ID: "post-01", State: "scheduled", ScheduledAt: time.Date(2025, 6, 2, 10, 40, 0, 0, india)},
+		{ID: "post-02", State: "scheduled", ScheduledAt: time.Date(2025, 6, 2, 10, 50, 0, 0, india)},
+	})
+
+	from := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	grid, err := client.CalendarGrid(context.Background(), from, from.Add(24*time.Hour), time.Hour)
+	require.NoError(t, err)
+
+	// Both posts share one slot even though each decoded time has its own location
+	require.Len(t, grid, 1)
+	posts := grid[time.Date(2025, 6, 2, 10, 0, 0, 0, india).UTC()]
+	require.Len(t, posts, 2)
+	assert.Equal(t, "post-01", posts[0].ID)
+	assert.Equal(t, "post-02", posts[1].ID)
+}
+
+func TestCalendarGridValidation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	now := time.Now()
+	_, err := client.CalendarGrid(context.Background(), now, now.Add(time.Hour), 0)
+	require.EqualError(t, err, "bucket must be positive")
+
+	_, err = client.CalendarGrid(context.Background(), now, now, time.Hour)
+	require.EqualError(t, err, "from must be before to")
+}
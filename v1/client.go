@@ -3,20 +3,41 @@ package v1
 import (
 	"bytes"
 	"context"
+	cryptorand "crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"maps"
 	"math/rand"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 const defaultBaseURL = "https://app.publer.com/api/v1/"
 
+// Regions accepted by Config.Region
+const (
+	RegionUS = "us"
+	RegionEU = "eu"
+)
+
+// regionBaseURLs maps each known region to its API base URL
+var regionBaseURLs = map[string]string{
+	RegionUS: defaultBaseURL,
+	RegionEU: "https://eu.publer.com/api/v1/",
+}
+
 // Package-level variables for validation
 var postIDRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
 
@@ -26,6 +47,156 @@ type Config struct {
 	WorkspaceID string
 	BaseURL     string
 	Client      *http.Client
+
+	// Region selects the API base URL by name (RegionUS or RegionEU) when BaseURL is
+	// unset. An explicit BaseURL always takes precedence, but an unknown Region is
+	// rejected either way.
+	Region string
+
+	// OnResponse is an optional hook invoked after each request completes
+	OnResponse func(info ResponseInfo)
+
+	// OnAttempt is an optional hook invoked after every attempt of a request, including
+	// each retry, for logging traffic without wrapping the http.Client. Sensitive
+	// headers such as Authorization are redacted before being passed to the hook.
+	OnAttempt func(req RequestInfo, resp ResponseInfo)
+
+	// RedactBodies replaces request and response bodies passed to OnResponse with a
+	// "[redacted N bytes]" placeholder so post content stays out of logs. Nil defaults to true.
+	RedactBodies *bool
+
+	// Now returns the current time when validating scheduled times (defaults to time.Now)
+	Now func() time.Time
+
+	// DefaultAccounts are used by Publish, Schedule and CreateDraft when a request
+	// has no accounts. Accounts provided on the request, or on the context with
+	// WithDefaultAccounts, take precedence.
+	DefaultAccounts []string
+
+	// Retry controls automatic retries of failed requests; retries are disabled by default
+	Retry RetryConfig
+
+	// OnRetry is an optional hook invoked before sleeping ahead of each retry
+	OnRetry func(attempt int, err error, nextDelay time.Duration)
+
+	// Timeout, when positive, bounds each HTTP attempt separately so every retry gets
+	// a fresh timeout. A deadline on the caller's context still applies when it is
+	// sooner. Attempts cut short by Timeout are retried like connection failures.
+	Timeout time.Duration
+
+	// ETagCache enables caching of GET responses that carry an ETag. Cached responses
+	// are revalidated with If-None-Match and reused when the server returns 304.
+	ETagCache bool
+
+	// CheckFeatures makes plan-restricted operations verify the feature is available via
+	// Features before sending, returning ErrFeatureNotAvailable instead of a 403
+	CheckFeatures bool
+
+	// BaseContext, when set, is merged with the context of every call so cancelling
+	// it aborts all active and future requests made by the client
+	BaseContext context.Context
+
+	// RateLimitStore holds the rate limit state reported by the API, shared with other
+	// clients using the same store. Before each request the client waits until the
+	// window resets if the store shows no requests remaining, and every response
+	// carrying X-RateLimit-Remaining updates the store. Defaults to a
+	// MemoryRateLimitStore of the client's own; pass one NewMemoryRateLimitStore to
+	// several clients to coordinate them within one process.
+	RateLimitStore RateLimitStore
+
+	// RateLimit throttles requests on the client side so that no more than
+	// RateLimit.Requests are sent in any RateLimit.Window, matching Publer's limit of
+	// 100 requests per 2 minutes. Requests block until the budget allows them or their
+	// context is done. Retries count against the budget. Disabled when Requests is 0.
+	RateLimit RateLimitConfig
+
+	// StrictBulkValidation makes BulkSchedule reject requests whose scheduled times are
+	// in different locations with a ValidationError. Times built from naive local
+	// values in several zones land at unexpected absolute times; schedule bulk posts
+	// in UTC to avoid this.
+	StrictBulkValidation bool
+
+	// GenerateCorrelationID tags every request with a random UUID in the
+	// X-Correlation-Id header unless one was added with WithHeader. Retries of a
+	// request reuse its ID.
+	GenerateCorrelationID bool
+
+	// DryRun captures requests instead of sending them. Every request is built as
+	// usual, recorded for DryRunRequests and answered with an empty 200 response,
+	// so results are left zero-valued and nothing reaches the API.
+	DryRun bool
+
+	// TolerantDecoding makes list iterators decode items one at a time. A malformed
+	// item is left out of the page and its error added to Page.ItemErrors rather
+	// than failing the page, so long syncs survive a single bad record. Drain
+	// helpers such as Collect skip malformed items silently; use NextPage to see them.
+	TolerantDecoding bool
+
+	// WarnMissingAltText logs a warning to Logger for each image attached to a post
+	// without alt text. The post is still sent.
+	WarnMissingAltText bool
+
+	// Logger receives warnings about requests the client sends anyway, such as images
+	// missing alt text. Nil uses slog.Default().
+	Logger *slog.Logger
+}
+
+// RetryConfig configures automatic retries of GET requests, and requests whose
+// context is marked with WithRetrySafe, that fail with a 429, 500, 502, 503 or 504
+// status or before any response is received, such as on a reset connection or an
+// attempt exceeding Config.Timeout.
+// Failures while reading a response body are never retried. A 429 carrying a
+// Retry-After header waits at least that long before the next attempt, even beyond
+// MaxDelay.
+type RetryConfig struct {
+	MaxAttempts int           // total attempts including the first; 0 or 1 disables retries
+	BaseDelay   time.Duration // delay before the first retry, doubled on each subsequent retry
+	MaxDelay    time.Duration // upper bound on the backoff delay between attempts; 0 means no limit
+}
+
+// RequestInfo describes a single attempt of a request for the OnAttempt hook
+type RequestInfo struct {
+	Method  string
+	URL     string
+	Header  http.Header // sent headers with sensitive values replaced by "[redacted]"
+	Attempt int         // 1 for the first attempt, incremented on each retry
+}
+
+// sensitiveHeaders are the request headers redacted before reaching OnAttempt
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie"}
+
+// redactHeader returns a copy of header with the values of sensitive headers redacted
+func redactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range sensitiveHeaders {
+		if _, ok := redacted[name]; ok {
+			redacted[name] = []string{"[redacted]"}
+		}
+	}
+	return redacted
+}
+
+// ResponseInfo describes a completed request for observability hooks
+type ResponseInfo struct {
+	Operation  string // set via WithOperation, defaults to "METHOD path"
+	Method     string
+	URL        string
+	StatusCode int // 0 when no response was received
+	Duration   time.Duration
+	Err        error
+
+	// RequestBody and ResponseBody hold the raw bodies, or a "[redacted N bytes]"
+	// placeholder when Config.RedactBodies is enabled
+	RequestBody  string
+	ResponseBody string
+}
+
+// DryRunRequest is a request captured instead of sent when Config.DryRun is enabled
+type DryRunRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
 }
 
 // Client represents the Publer API client
@@ -33,6 +204,49 @@ type Client struct {
 	config     Config
 	httpClient *http.Client
 	baseURL    string
+	now        func() time.Time
+	networks   *networkCache
+	features   *featureCache
+	retries    *atomic.Int64
+	etags      *etagCache
+	fromCache  *atomic.Bool
+	// correlationID holds the X-Correlation-Id sent with the most recent request
+	correlationID *atomic.Pointer[string]
+	dryRun        *dryRunLog
+	limiter       *tokenBucket
+	// err is returned by every request of a client WithWorkspace scoped to an invalid
+	// workspace ID
+	err error
+}
+
+// dryRunLog holds the requests captured in dry-run mode
+type dryRunLog struct {
+	mu       sync.Mutex
+	requests []DryRunRequest
+}
+
+// featureCache holds the plan features once fetched
+type featureCache struct {
+	mu       sync.Mutex
+	features map[string]bool
+}
+
+// etagCache holds GET response bodies keyed by the workspace the request was sent to
+// and URL, along with their ETag
+type etagCache struct {
+	mu      sync.Mutex
+	entries map[string]etagEntry
+}
+
+type etagEntry struct {
+	etag string
+	body []byte
+}
+
+// networkCache holds the supported networks once fetched
+type networkCache struct {
+	mu       sync.Mutex
+	networks []NetworkInfo
 }
 
 // NewClient creates a new Publer API client
@@ -50,6 +264,15 @@ func NewClient(config Config) (*Client, error) {
 	}
 
 	baseURL := config.BaseURL
+	if config.Region != "" {
+		regionURL, ok := regionBaseURLs[config.Region]
+		if !ok {
+			return nil, fmt.Errorf("unknown region %q: must be one of us or eu", config.Region)
+		}
+		if baseURL == "" {
+			baseURL = regionURL
+		}
+	}
 	if baseURL == "" {
 		baseURL = defaultBaseURL
 	}
@@ -58,15 +281,60 @@ func NewClient(config Config) (*Client, error) {
 		baseURL += "/"
 	}
 
+	now := config.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	if config.RateLimitStore == nil {
+		config.RateLimitStore = NewMemoryRateLimitStore()
+	}
+
 	return &Client{
-		config:     config,
-		httpClient: httpClient,
-		baseURL:    baseURL,
+		config:        config,
+		httpClient:    httpClient,
+		baseURL:       baseURL,
+		now:           now,
+		networks:      &networkCache{},
+		features:      &featureCache{},
+		retries:       &atomic.Int64{},
+		etags:         &etagCache{entries: make(map[string]etagEntry)},
+		fromCache:     &atomic.Bool{},
+		correlationID: &atomic.Pointer[string]{},
+		dryRun:        &dryRunLog{},
+		limiter:       newTokenBucket(config.RateLimit),
 	}, nil
 }
 
-// do performs HTTP requests with authentication
+// do performs HTTP requests with authentication, encoding body as JSON
 func (c *Client) do(ctx context.Context, method, path string, body any, result any) error {
+	var jsonBody []byte
+	if body != nil {
+		var err error
+		jsonBody, err = json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+	}
+	return c.doRaw(ctx, method, path, "application/json", jsonBody, result)
+}
+
+// doRaw performs HTTP requests with authentication using an already encoded body
+func (c *Client) doRaw(ctx context.Context, method, path, contentType string, body []byte, result any) (err error) {
+	if c.err != nil {
+		return c.err
+	}
+
+	if c.config.BaseContext != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = mergeContext(ctx, c.config.BaseContext)
+		defer cancel()
+	}
+
+	if c.config.GenerateCorrelationID && headersFromContext(ctx).Get(correlationIDHeader) == "" {
+		ctx = WithHeader(ctx, correlationIDHeader, newUUID())
+	}
+
 	// Build the full URL
 	u, err := url.Parse(c.baseURL)
 	if err != nil {
@@ -83,42 +351,385 @@ func (c *Client) do(ctx context.Context, method, path string, body any, result a
 
 	fullURL := u.ResolveReference(rel).String()
 
-	// Prepare request body
+	// Report the outcome to the observability hook once the request completes
+	var statusCode int
+	var respBody []byte
+	start := time.Now()
+	defer func() {
+		if c.config.OnResponse == nil {
+			return
+		}
+		c.config.OnResponse(ResponseInfo{
+			Operation:    operationFromContext(ctx, method+" "+rel.Path),
+			Method:       method,
+			URL:          fullURL,
+			StatusCode:   statusCode,
+			Duration:     time.Since(start),
+			Err:          err,
+			RequestBody:  c.logBody(body),
+			ResponseBody: c.logBody(respBody),
+		})
+	}()
+
+	for attempt := 1; ; attempt++ {
+		if err = c.waitForRateLimit(ctx); err != nil {
+			return err
+		}
+		if err = c.limiter.wait(ctx); err != nil {
+			return err
+		}
+		attemptStart := time.Now()
+		attemptCtx, cancel := c.attemptContext(ctx)
+		statusCode, respBody, err = c.send(attemptCtx, method, fullURL, contentType, body, result)
+		var reqErr *requestError
+		if errors.As(err, &reqErr) && ctx.Err() == nil && attemptCtx.Err() != nil {
+			err = &timeoutError{err: err, timeout: c.config.Timeout}
+		}
+		cancel()
+		if c.config.OnAttempt != nil {
+			c.config.OnAttempt(RequestInfo{
+				Method:  method,
+				URL:     fullURL,
+				Header:  redactHeader(c.requestHeader(ctx, contentType, body != nil)),
+				Attempt: attempt,
+			}, ResponseInfo{
+				Operation:    operationFromContext(ctx, method+" "+rel.Path),
+				Method:       method,
+				URL:          fullURL,
+				StatusCode:   statusCode,
+				Duration:     time.Since(attemptStart),
+				Err:          err,
+				RequestBody:  c.logBody(body),
+				ResponseBody: c.logBody(respBody),
+			})
+		}
+		if !c.shouldRetry(ctx, method, attempt, err) {
+			return err
+		}
+
+		delay := max(c.retryDelay(attempt), retryAfter(err))
+		if c.config.OnRetry != nil {
+			c.config.OnRetry(attempt, err, delay)
+		}
+		c.retries.Add(1)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// logBody returns body as it should appear in ResponseInfo, honoring Config.RedactBodies
+func (c *Client) logBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if c.config.RedactBodies == nil || *c.config.RedactBodies {
+		return fmt.Sprintf("[redacted %d bytes]", len(body))
+	}
+	return string(body)
+}
+
+// shouldRetry reports whether a failed attempt is eligible for another try
+func (c *Client) shouldRetry(ctx context.Context, method string, attempt int, err error) bool {
+	if err == nil || attempt >= c.config.Retry.MaxAttempts {
+		return false
+	}
+	if method != http.MethodGet && !retrySafeFromContext(ctx) {
+		return false
+	}
+
+	return isTransientError(err)
+}
+
+// requestError reports a request that failed before any response was received, such
+// as a refused or reset connection
+type requestError struct {
+	err error
+}
+
+func (e *requestError) Error() string {
+	return "request failed: " + e.err.Error()
+}
+
+func (e *requestError) Unwrap() error {
+	return e.err
+}
+
+// attemptContext returns the context for a single attempt, bounded by Config.Timeout
+// when set
+func (c *Client) attemptContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.config.Timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.config.Timeout)
+}
+
+// timeoutError reports an attempt cut short by Config.Timeout while the caller's
+// context was still live
+type timeoutError struct {
+	err     error
+	timeout time.Duration
+}
+
+func (e *timeoutError) Error() string {
+	return fmt.Sprintf("request timed out after %s: %v", e.timeout, e.err)
+}
+
+func (e *timeoutError) Unwrap() error {
+	return e.err
+}
+
+// isTransientError reports whether err is a rate limit, a server error or a failure
+// to reach the server worth retrying. Cancelled or expired caller contexts are not
+// transient, while an attempt cut short by Config.Timeout is.
+func isTransientError(err error) bool {
+	var timeoutErr *timeoutError
+	if errors.As(err, &timeoutErr) {
+		return true
+	}
+
+	var reqErr *requestError
+	if errors.As(err, &reqErr) {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header given as delta-seconds or an HTTP-date,
+// returning the delay from now and the time it ends. Unparseable values yield zero.
+func parseRetryAfter(value string, now time.Time) (time.Duration, time.Time) {
+	if seconds, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+		if seconds < 0 {
+			return 0, time.Time{}
+		}
+		delay := time.Duration(seconds) * time.Second
+		return delay, now.Add(delay)
+	}
+	at, err := http.ParseTime(value)
+	if err != nil {
+		return 0, time.Time{}
+	}
+	return max(at.Sub(now), 0), at
+}
+
+// retryAfter returns the Retry-After delay of a rate limit error, or 0 for any other error
+func retryAfter(err error) time.Duration {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return rateLimitErr.RetryAfter
+	}
+	return 0
+}
+
+// retryDelay returns the exponential backoff delay to wait after the given attempt
+func (c *Client) retryDelay(attempt int) time.Duration {
+	maxDelay := c.config.Retry.MaxDelay
+	delay := c.config.Retry.BaseDelay
+	for i := 1; i < attempt && (maxDelay == 0 || delay < maxDelay); i++ {
+		delay *= 2
+	}
+	if maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// waitForRateLimit blocks until the window resets when Config.RateLimitStore shows
+// no requests remaining
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	state, err := c.config.RateLimitStore.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read rate limit state: %w", err)
+	}
+	if state.Remaining > 0 || state.Reset.IsZero() {
+		return nil
+	}
+
+	wait := state.Reset.Sub(c.now())
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RateLimitState reports the client-side budget configured by Config.RateLimit: the
+// requests that may be sent right away and when the next spent request is returned
+// to the budget, zero when none are spent. Remaining is -1 when the limiter is disabled.
+func (c *Client) RateLimitState() RateLimitState {
+	return c.limiter.state()
+}
+
+// storeRateLimit records the reported rate limit in Config.RateLimitStore. reset is
+// the X-RateLimit-Reset header in Unix seconds, if any. Store failures are ignored
+// since the response has already been received.
+func (c *Client) storeRateLimit(ctx context.Context, remaining int, reset string) {
+	state := RateLimitState{Remaining: remaining}
+	if seconds, err := strconv.ParseInt(reset, 10, 64); err == nil {
+		state.Reset = time.Unix(seconds, 0)
+	}
+	_ = c.config.RateLimitStore.Set(ctx, state)
+}
+
+// RetryCount returns the total number of retries performed by this client
+func (c *Client) RetryCount() int {
+	return int(c.retries.Load())
+}
+
+// LastFromCache reports whether the most recent GET was answered with 304 Not Modified
+// and served from the ETag cache. With concurrent requests "most recent" is whichever
+// GET finished last.
+func (c *Client) LastFromCache() bool {
+	return c.fromCache.Load()
+}
+
+// LastCorrelationID returns the X-Correlation-Id sent with the most recent request, or
+// an empty string when it carried none. With concurrent requests "most recent" is
+// whichever request was sent last.
+func (c *Client) LastCorrelationID() string {
+	if id := c.correlationID.Load(); id != nil {
+		return *id
+	}
+	return ""
+}
+
+// DryRunRequests returns the requests captured so far when Config.DryRun is enabled
+func (c *Client) DryRunRequests() []DryRunRequest {
+	c.dryRun.mu.Lock()
+	defer c.dryRun.mu.Unlock()
+	return slices.Clone(c.dryRun.requests)
+}
+
+// correlationIDHeader carries the ID tying a request to server side logs
+const correlationIDHeader = "X-Correlation-Id"
+
+// newUUID returns a random version 4 UUID
+func newUUID() string {
+	var b [16]byte
+	_, _ = cryptorand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// requestHeader returns the headers sent with every request made with ctx
+func (c *Client) requestHeader(ctx context.Context, contentType string, hasBody bool) http.Header {
+	header := make(http.Header)
+
+	// Add authentication headers
+	header.Set("Authorization", fmt.Sprintf("Bearer-API %s", c.config.APIKey))
+	header.Set("Publer-Workspace-Id", c.config.WorkspaceID)
+
+	// Apply per-request headers added with WithHeader
+	for name, values := range headersFromContext(ctx) {
+		header[name] = values
+	}
+
+	// Add content type of the encoded body
+	if hasBody {
+		header.Set("Content-Type", contentType)
+	}
+	return header
+}
+
+// send performs a single HTTP attempt and returns the response status code
+// (0 when no response was received) along with the raw response body
+func (c *Client) send(ctx context.Context, method, fullURL, contentType string, body []byte, result any) (int, []byte, error) {
 	var reqBody io.Reader
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
-		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
-		}
-		reqBody = bytes.NewReader(jsonBody)
+		reqBody = bytes.NewReader(body)
 	}
 
 	// Create request
 	req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return 0, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Add authentication headers
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer-API %s", c.config.APIKey))
-	req.Header.Set("Publer-Workspace-Id", c.config.WorkspaceID)
+	req.Header = c.requestHeader(ctx, contentType, body != nil)
+
+	correlationID := req.Header.Get(correlationIDHeader)
+	c.correlationID.Store(&correlationID)
+
+	if c.config.DryRun {
+		c.dryRun.mu.Lock()
+		c.dryRun.requests = append(c.dryRun.requests, DryRunRequest{
+			Method: method,
+			URL:    fullURL,
+			Header: req.Header.Clone(),
+			Body:   body,
+		})
+		c.dryRun.mu.Unlock()
+		return http.StatusOK, nil, nil
+	}
 
-	// Add content type for JSON
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	// Revalidate cached GET responses
+	useCache := c.config.ETagCache && method == http.MethodGet
+	cacheKey := req.Header.Get("Publer-Workspace-Id") + " " + fullURL
+	var cached etagEntry
+	if useCache {
+		c.etags.mu.Lock()
+		cached = c.etags.entries[cacheKey]
+		c.etags.mu.Unlock()
+		if cached.etag != "" {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
 	}
 
 	// Execute request
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return 0, nil, &requestError{err: err}
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if meta := responseMetaFromContext(ctx); meta != nil {
+		meta.StatusCode = resp.StatusCode
+		meta.Header = resp.Header.Clone()
+	}
+
 	// Read response body
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return resp.StatusCode, respBody, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if remaining := remainingFromHeader(resp.Header); remaining >= 0 {
+		c.storeRateLimit(ctx, remaining, resp.Header.Get("X-RateLimit-Reset"))
+	}
+
+	if method == http.MethodGet {
+		notModified := useCache && cached.etag != "" && resp.StatusCode == http.StatusNotModified
+		c.fromCache.Store(notModified)
+
+		switch {
+		case notModified:
+			respBody = cached.body
+		case useCache && resp.StatusCode == http.StatusOK && resp.Header.Get("ETag") != "":
+			c.etags.mu.Lock()
+			c.etags.entries[cacheKey] = etagEntry{etag: resp.Header.Get("ETag"), body: respBody}
+			c.etags.mu.Unlock()
+		}
 	}
 
 	// Handle errors
@@ -127,9 +738,11 @@ func (c *Client) do(ctx context.Context, method, path string, body any, result a
 			// Rate limit error
 			rateLimitErr := &RateLimitError{
 				APIError: APIError{
-					Method:     method,
-					URL:        fullURL,
-					StatusCode: resp.StatusCode,
+					Method:        method,
+					URL:           fullURL,
+					StatusCode:    resp.StatusCode,
+					Body:          string(respBody),
+					CorrelationID: correlationID,
 				},
 			}
 
@@ -149,29 +762,36 @@ func (c *Client) do(ctx context.Context, method, path string, body any, result a
 					rateLimitErr.Reset = 0
 				}
 			}
+			if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+				rateLimitErr.RetryAfter, rateLimitErr.retryAt = parseRetryAfter(retryAfter, c.now())
+			}
 
 			// Try to parse error message from body
 			var errResp ErrorResponse
 			if err := json.Unmarshal(respBody, &errResp); err == nil {
+				rateLimitErr.Code = errResp.Code
 				rateLimitErr.Message = errResp.Message
 				if rateLimitErr.Message == "" {
 					rateLimitErr.Message = errResp.Error
 				}
 			}
 
-			return rateLimitErr
+			return resp.StatusCode, respBody, rateLimitErr
 		}
 
 		// Regular API error
 		apiErr := &APIError{
-			Method:     method,
-			URL:        fullURL,
-			StatusCode: resp.StatusCode,
+			Method:        method,
+			URL:           fullURL,
+			StatusCode:    resp.StatusCode,
+			Body:          string(respBody),
+			CorrelationID: correlationID,
 		}
 
 		// Try to parse error message from body
 		var errResp ErrorResponse
 		if err := json.Unmarshal(respBody, &errResp); err == nil {
+			apiErr.Code = errResp.Code
 			apiErr.Message = errResp.Message
 			if apiErr.Message == "" {
 				apiErr.Message = errResp.Error
@@ -179,20 +799,35 @@ func (c *Client) do(ctx context.Context, method, path string, body any, result a
 		}
 
 		if apiErr.Message == "" {
-			apiErr.Message = string(respBody)
+			// Summarize markup such as a proxy's HTML error page instead of embedding it
+			if mediaType := errorMediaType(resp.Header); mediaType != "" {
+				apiErr.Message = fmt.Sprintf("upstream returned %s %d", mediaType, resp.StatusCode)
+			} else {
+				apiErr.Message = string(respBody)
+			}
 		}
 
-		return apiErr
+		return resp.StatusCode, respBody, apiErr
 	}
 
 	// Parse successful response
 	if result != nil && len(respBody) > 0 {
 		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
+			return resp.StatusCode, respBody, fmt.Errorf("failed to unmarshal response: %w", err)
 		}
 	}
 
-	return nil
+	return resp.StatusCode, respBody, nil
+}
+
+// errorMediaType returns the media type of an error response when it is neither JSON
+// nor plain text, or an empty string otherwise
+func errorMediaType(header http.Header) string {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil || mediaType == "text/plain" || strings.HasSuffix(mediaType, "json") {
+		return ""
+	}
+	return mediaType
 }
 
 // Test performs a test request to verify connectivity (for testing purposes only)
@@ -201,265 +836,1714 @@ func (c *Client) Test(ctx context.Context) error {
 	return c.do(ctx, "GET", "test", nil, &result)
 }
 
+// VerifyCredentials makes a lightweight authenticated request to confirm the API key
+// and workspace ID are accepted. It returns an error wrapping ErrInvalidAPIKey or
+// ErrInvalidWorkspace when the API rejects either, or nil on success.
+func (c *Client) VerifyCredentials(ctx context.Context) error {
+	var resp ListAccountsResponse
+	err := c.do(ctx, "GET", "accounts", nil, &resp)
+	if err == nil {
+		return nil
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch {
+		case apiErr.StatusCode == http.StatusUnauthorized:
+			return fmt.Errorf("%w: %w", ErrInvalidAPIKey, err)
+		case apiErr.StatusCode == http.StatusBadRequest && strings.Contains(strings.ToLower(apiErr.Message), "workspace"):
+			return fmt.Errorf("%w: %w", ErrInvalidWorkspace, err)
+		}
+	}
+	return err
+}
+
 // ============================================================================
 // Post Publishing Operations
 // ============================================================================
 
 // Publish publishes content immediately
 func (c *Client) Publish(ctx context.Context, request PublishRequest, response *PublishResponse) error {
+	accounts, err := c.resolveAccounts(ctx, request.Accounts)
+	if err != nil {
+		return err
+	}
+	request.Accounts = accounts
+
+	if err := validatePostKind(request.PostKind); err != nil {
+		return err
+	}
+	if err := validateThread(request.Thread); err != nil {
+		return err
+	}
+	if err := validatePoll(request.Poll); err != nil {
+		return err
+	}
+	if !request.ExpiresAt.IsZero() && !request.ExpiresAt.After(c.now()) {
+		return fmt.Errorf("expiration must be in the future")
+	}
+	c.warnMissingAltText(ctx, request.Media)
+
+	if request.UTM != nil {
+		if err := appendPostUTM(*request.UTM, &request.Text, &request.Link, &request.Variants, &request.Thread); err != nil {
+			return err
+		}
+	}
 	return c.do(ctx, "POST", "posts/schedule/publish", request, response)
 }
 
 // BulkPublish publishes multiple posts immediately
 func (c *Client) BulkPublish(ctx context.Context, req BulkPublishRequest, resp *BulkPublishResponse) error {
+	for _, post := range req.Posts {
+		c.warnMissingAltText(ctx, post.Media)
+	}
 	return c.do(ctx, "POST", "posts/schedule/publish", req, resp)
 }
 
-// ============================================================================
-// Post Scheduling Operations
-// ============================================================================
+// CheckDuplicate reports whether text would be flagged as a duplicate of content
+// recently posted to the account
+func (c *Client) CheckDuplicate(ctx context.Context, accountID, text string) (bool, error) {
+	if accountID == "" {
+		return false, fmt.Errorf("account ID cannot be empty")
+	}
+	if strings.TrimSpace(text) == "" {
+		return false, fmt.Errorf("text cannot be empty")
+	}
 
-// Schedule schedules a post for future publication
-func (c *Client) Schedule(ctx context.Context, req ScheduleRequest, resp *ScheduleResponse) error {
-	return c.do(ctx, "POST", "posts/schedule", req, resp)
+	var resp DuplicateCheckResponse
+	req := DuplicateCheckRequest{AccountID: accountID, Text: text}
+	if err := c.do(ctx, "POST", "posts/duplicate_check", req, &resp); err != nil {
+		return false, err
+	}
+	return resp.Duplicate, nil
 }
 
-// CreateDraft creates a draft post
-func (c *Client) CreateDraft(ctx context.Context, req CreateDraftRequest, resp *CreateDraftResponse) error {
-	return c.do(ctx, "POST", "posts/schedule", req, resp)
+// resolveAccounts returns the request accounts, falling back to the accounts set with
+// WithDefaultAccounts and then Config.DefaultAccounts when the request has none
+func (c *Client) resolveAccounts(ctx context.Context, accounts []string) ([]string, error) {
+	if len(accounts) > 0 {
+		return accounts, nil
+	}
+	if fromContext := defaultAccountsFromContext(ctx); len(fromContext) > 0 {
+		return fromContext, nil
+	}
+	if len(c.config.DefaultAccounts) > 0 {
+		return c.config.DefaultAccounts, nil
+	}
+	return nil, fmt.Errorf("at least one account is required")
 }
 
-// BulkSchedule schedules multiple posts
-func (c *Client) BulkSchedule(ctx context.Context, req BulkScheduleRequest, resp *BulkScheduleResponse) error {
-	return c.do(ctx, "POST", "posts/schedule", req, resp)
+// validatePostKind checks the post kind is empty or one of the known kinds. Requests
+// only carry account IDs, so whether each account's network accepts the kind is
+// enforced by the server.
+func validatePostKind(kind string) error {
+	switch kind {
+	case "", PostKindFeed, PostKindStory, PostKindReel, PostKindShort:
+		return nil
+	}
+	return fmt.Errorf("invalid post kind %q: must be one of feed, story, reel or short", kind)
 }
 
-// ============================================================================
-// Post Management Operations
-// ============================================================================
+// validateThread checks every follow-up part of a thread has text. Requests only carry
+// account IDs, so whether a single targeted network supports threads is enforced by
+// the server.
+func validateThread(thread []string) error {
+	for i, part := range thread {
+		if strings.TrimSpace(part) == "" {
+			return fmt.Errorf("thread part %d cannot be empty", i+1)
+		}
+	}
+	return nil
+}
 
-// Client-side validation is necessary to prevent path traversal attacks when constructing URLs.
-// Without validation, malicious PostIDs like "../admin" could access unintended endpoints.
-func validatePostID(postID string) error {
-	if postID == "" {
-		return fmt.Errorf("post ID cannot be empty")
+// validatePoll checks a poll, when present, has 2 to 4 non-empty options and a
+// positive duration
+func validatePoll(poll *Poll) error {
+	if poll == nil {
+		return nil
 	}
-	if strings.Contains(postID, "..") || strings.Contains(postID, "/") || strings.Contains(postID, "\\") {
-		return fmt.Errorf("post ID contains invalid characters")
+	if len(poll.Options) < minPollOptions || len(poll.Options) > maxPollOptions {
+		return fmt.Errorf("poll must have between %d and %d options, got %d", minPollOptions, maxPollOptions, len(poll.Options))
 	}
-	if !postIDRegex.MatchString(postID) {
-		return fmt.Errorf("post ID must contain only alphanumeric characters, hyphens, and underscores")
+	for i, option := range poll.Options {
+		if strings.TrimSpace(option) == "" {
+			return fmt.Errorf("poll option %d cannot be empty", i+1)
+		}
+	}
+	if poll.Duration <= 0 {
+		return fmt.Errorf("poll duration must be positive")
 	}
 	return nil
 }
 
-// GetPost retrieves a single post by ID
-func (c *Client) GetPost(ctx context.Context, req GetPostRequest, resp *GetPostResponse) error {
-	if err := validatePostID(req.PostID); err != nil {
-		return fmt.Errorf("invalid post ID: %w", err)
+// warnMissingAltText logs each image without alt text when Config.WarnMissingAltText
+// is enabled
+func (c *Client) warnMissingAltText(ctx context.Context, media []Media) {
+	if !c.config.WarnMissingAltText {
+		return
 	}
-	path := fmt.Sprintf("posts/%s", req.PostID)
-	return c.do(ctx, "GET", path, nil, resp)
-}
-
-// UpdatePost updates an existing post
-func (c *Client) UpdatePost(ctx context.Context, req UpdatePostRequest, resp *UpdatePostResponse) error {
-	if err := validatePostID(req.PostID); err != nil {
-		return fmt.Errorf("invalid post ID: %w", err)
+	logger := c.config.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	for i, item := range media {
+		if item.Type == "image" && strings.TrimSpace(item.AltText) == "" {
+			logger.WarnContext(ctx, "image has no alt text", "media", i+1, "url", item.URL)
+		}
 	}
-	path := fmt.Sprintf("posts/%s", req.PostID)
-	return c.do(ctx, "PATCH", path, req, resp)
 }
 
-// DeletePost deletes a post
-func (c *Client) DeletePost(ctx context.Context, req DeletePostRequest, resp *DeletePostResponse) error {
-	if err := validatePostID(req.PostID); err != nil {
-		return fmt.Errorf("invalid post ID: %w", err)
+// validateFutureTime checks the scheduled time is after the client's current time
+func (c *Client) validateFutureTime(scheduledAt time.Time) error {
+	if !scheduledAt.After(c.now()) {
+		return fmt.Errorf("scheduled time must be in the future")
 	}
-	path := fmt.Sprintf("posts/%s", req.PostID)
-	return c.do(ctx, "DELETE", path, nil, resp)
+	return nil
 }
 
 // ============================================================================
-// Post Listing Operations
+// Post Scheduling Operations
 // ============================================================================
 
-// ListPosts retrieves posts with filtering options
-func (c *Client) ListPosts(ctx context.Context, request ListPostsRequest) Iterator[Post] {
-	return NewPostIterator(c, request)
-}
-
-// ============================================================================
-// Post Advanced Operations
-// ============================================================================
+// Schedule schedules a post for future publication
+func (c *Client) Schedule(ctx context.Context, req ScheduleRequest, resp *ScheduleResponse) error {
+	accounts, err := c.resolveAccounts(ctx, req.Accounts)
+	if err != nil {
+		return err
+	}
+	req.Accounts = accounts
 
-// CreateRecurringPost creates a recurring post schedule
-func (c *Client) CreateRecurringPost(ctx context.Context, req RecurringPostRequest, resp *RecurringPostResponse) error {
-	return c.do(ctx, "POST", "posts/recurring", req, resp)
+	if err := validatePostKind(req.PostKind); err != nil {
+		return err
+	}
+	if err := validateThread(req.Thread); err != nil {
+		return err
+	}
+	if err := validatePoll(req.Poll); err != nil {
+		return err
+	}
+	if req.Auto {
+		if !req.ScheduledAt.IsZero() {
+			return fmt.Errorf("scheduled time cannot be set when auto scheduling")
+		}
+	} else if err := c.validateFutureTime(req.ScheduledAt); err != nil {
+		return err
+	}
+	if !req.ExpiresAt.IsZero() {
+		if req.Auto && !req.ExpiresAt.After(c.now()) {
+			return fmt.Errorf("expiration must be in the future")
+		}
+		if !req.Auto && !req.ExpiresAt.After(req.ScheduledAt) {
+			return fmt.Errorf("expiration must be after the scheduled time")
+		}
+	}
+	c.warnMissingAltText(ctx, req.Media)
+
+	if req.UTM != nil {
+		if err := appendPostUTM(*req.UTM, &req.Text, &req.Link, &req.Variants, &req.Thread); err != nil {
+			return err
+		}
+	}
+
+	var skipped []string
+	if req.SkipInactiveAccounts {
+		req.Accounts, skipped, err = c.activeAccounts(ctx, req.Accounts)
+		if err != nil {
+			return err
+		}
+		if len(req.Accounts) == 0 {
+			return fmt.Errorf("no active accounts to schedule to: skipped %s", strings.Join(skipped, ", "))
+		}
+	}
+
+	if err := c.do(ctx, "POST", "posts/schedule", req, resp); err != nil {
+		return err
+	}
+	if resp != nil {
+		resp.SkippedAccounts = skipped
+	}
+	return nil
+}
+
+// activeAccounts splits accountIDs into those that are active and those whose
+// connection is not. Accounts without a state or missing from the workspace are
+// treated as active and left for the API to validate.
+func (c *Client) activeAccounts(ctx context.Context, accountIDs []string) (active, inactive []string, err error) {
+	accounts, err := Collect(ctx, c.ListAccounts(ctx, ListAccountsRequest{}))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	states := make(map[string]string, len(accounts))
+	for _, account := range accounts {
+		states[account.ID] = account.State
+	}
+	for _, accountID := range accountIDs {
+		if state := states[accountID]; state == "" || state == AccountStateActive {
+			active = append(active, accountID)
+		} else {
+			inactive = append(inactive, accountID)
+		}
+	}
+	return active, inactive, nil
+}
+
+// CreateDraft creates a draft post
+func (c *Client) CreateDraft(ctx context.Context, req CreateDraftRequest, resp *CreateDraftResponse) error {
+	accounts, err := c.resolveAccounts(ctx, req.Accounts)
+	if err != nil {
+		return err
+	}
+	req.Accounts = accounts
+
+	return c.do(ctx, "POST", "posts/schedule", req, resp)
+}
+
+// BulkSchedule schedules multiple posts
+func (c *Client) BulkSchedule(ctx context.Context, req BulkScheduleRequest, resp *BulkScheduleResponse) error {
+	for i, post := range req.Posts {
+		if post.PublishNow && !post.ScheduledAt.IsZero() {
+			return fmt.Errorf("post %d: scheduled time cannot be set when publishing now", i+1)
+		}
+	}
+	if c.config.StrictBulkValidation {
+		if err := validateBulkTimeZones(req.Posts); err != nil {
+			return err
+		}
+	}
+	for _, post := range req.Posts {
+		c.warnMissingAltText(ctx, post.Media)
+	}
+	return c.do(ctx, "POST", "posts/schedule", req, resp)
+}
+
+// validateBulkTimeZones returns a ValidationError for the first post whose scheduled
+// time is in a different location than the earlier scheduled posts
+func validateBulkTimeZones(posts []BulkPost) error {
+	first := -1
+	for i, post := range posts {
+		if post.ScheduledAt.IsZero() {
+			continue
+		}
+		if first < 0 {
+			first = i
+			continue
+		}
+		want := posts[first].ScheduledAt.Location().String()
+		if got := post.ScheduledAt.Location().String(); got != want {
+			msg := fmt.Sprintf("Scheduled time is in %s but post %d is in %s; use UTC for every post", got, first+1, want)
+			return &ValidationError{Field: fmt.Sprintf("posts[%d].scheduled_at", i), Message: msg}
+		}
+	}
+	return nil
+}
+
+// BulkScheduleSync submits a bulk schedule request, waits for its job and returns the
+// IDs of the created posts. When some posts fail the IDs of those that succeeded are
+// returned together with an error joining the failure of each item.
+func (c *Client) BulkScheduleSync(ctx context.Context, req BulkScheduleRequest, opts WaitOptions) ([]string, error) {
+	var resp BulkScheduleResponse
+	if err := c.BulkSchedule(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	opts.JobID = resp.JobID
+	var result JobResult
+	err := c.WaitForJob(ctx, opts, &result)
+	return bulkJobOutcome(resp.JobID, result, err)
+}
+
+// Result is the outcome of a bulk schedule job reported by BulkScheduleWithProgress
+type Result struct {
+	PostIDs []string // posts that were created, also set on partial failure
+	Err     error
+}
+
+// BulkScheduleWithProgress submits a bulk schedule request and streams the status of
+// its job on the returned progress channel until the job finishes, polling as WatchJob
+// does with the options set by WithWaitOptions. The progress channel is then closed and
+// a single result with the created post IDs, or the error that stopped the operation,
+// is sent on the result channel. Cancelling ctx stops polling.
+func (c *Client) BulkScheduleWithProgress(ctx context.Context, req BulkScheduleRequest) (<-chan JobStatus, <-chan Result) {
+	progress := make(chan JobStatus)
+	results := make(chan Result, 1)
+
+	go func() {
+		defer close(results)
+
+		var resp BulkScheduleResponse
+		if err := c.BulkSchedule(ctx, req, &resp); err != nil {
+			close(progress)
+			results <- Result{Err: err}
+			return
+		}
+
+		opts := waitOptionsFromContext(ctx)
+		opts.JobID = resp.JobID
+		statuses, errs := c.WatchJob(ctx, opts)
+		var last JobStatus
+		for status := range statuses {
+			last = status
+			select {
+			case progress <- status:
+			case <-ctx.Done():
+			}
+		}
+		close(progress)
+
+		// The final status carries the job result
+		var result JobResult
+		switch {
+		case last.Result != nil:
+			result = *last.Result
+		case last.Status == "completed":
+			result.Success = true
+		}
+		postIDs, err := bulkJobOutcome(resp.JobID, result, <-errs)
+		results <- Result{PostIDs: postIDs, Err: err}
+	}()
+
+	return progress, results
+}
+
+// bulkJobOutcome returns the post IDs created by a finished bulk job along with an
+// error joining the failure of each item when the job only partially succeeded
+func bulkJobOutcome(jobID string, result JobResult, err error) ([]string, error) {
+	if err != nil {
+		return result.PostIDs, err
+	}
+	if result.Success {
+		return result.PostIDs, nil
+	}
+
+	itemErrors, err := result.ItemErrors()
+	if err != nil {
+		return result.PostIDs, err
+	}
+	if len(itemErrors) == 0 {
+		return result.PostIDs, fmt.Errorf("job %s: %s", jobID, result.Error)
+	}
+	errs := make([]error, len(itemErrors))
+	for i, item := range itemErrors {
+		errs[i] = fmt.Errorf("post %d: %s", item.PostIndex+1, item.Error)
+	}
+	return result.PostIDs, errors.Join(errs...)
+}
+
+// ============================================================================
+// Post Management Operations
+// ============================================================================
+
+// Client-side validation is necessary to prevent path traversal attacks when constructing URLs.
+// Without validation, malicious PostIDs like "../admin" could access unintended endpoints.
+func validatePostID(postID string) error {
+	if postID == "" {
+		return fmt.Errorf("post ID cannot be empty")
+	}
+	if strings.Contains(postID, "..") || strings.Contains(postID, "/") || strings.Contains(postID, "\\") {
+		return fmt.Errorf("post ID contains invalid characters")
+	}
+	if !postIDRegex.MatchString(postID) {
+		return fmt.Errorf("post ID must contain only alphanumeric characters, hyphens, and underscores")
+	}
+	return nil
+}
+
+// GetPost retrieves a single post by ID
+func (c *Client) GetPost(ctx context.Context, req GetPostRequest, resp *GetPostResponse) error {
+	if err := validatePostID(req.PostID); err != nil {
+		return fmt.Errorf("invalid post ID: %w", err)
+	}
+	path := fmt.Sprintf("posts/%s", req.PostID)
+	return c.do(ctx, "GET", path, nil, resp)
+}
+
+// GetPostComments returns an iterator over the comments and replies on a published post
+func (c *Client) GetPostComments(ctx context.Context, req GetPostCommentsRequest) Iterator[Comment] {
+	if err := validatePostID(req.PostID); err != nil {
+		return &errIterator[Comment]{err: fmt.Errorf("invalid post ID: %w", err)}
+	}
+	return NewGenericIterator[Comment](&commentFetcher{client: c, req: req})
+}
+
+// GetPostAnalytics retrieves engagement metrics for a published post
+func (c *Client) GetPostAnalytics(ctx context.Context, postID string) (PostAnalytics, error) {
+	if err := validatePostID(postID); err != nil {
+		return PostAnalytics{}, fmt.Errorf("invalid post ID: %w", err)
+	}
+
+	var analytics PostAnalytics
+	path := fmt.Sprintf("posts/%s/analytics", postID)
+	if err := c.do(ctx, "GET", path, nil, &analytics); err != nil {
+		return PostAnalytics{}, err
+	}
+	return analytics, nil
+}
+
+// ReplyToComment posts a reply to a comment on a published post
+func (c *Client) ReplyToComment(ctx context.Context, req ReplyToCommentRequest, resp *ReplyToCommentResponse) error {
+	if err := validatePostID(req.PostID); err != nil {
+		return fmt.Errorf("invalid post ID: %w", err)
+	}
+	if err := validateCommentID(req.CommentID); err != nil {
+		return fmt.Errorf("invalid comment ID: %w", err)
+	}
+	if strings.TrimSpace(req.Text) == "" {
+		return fmt.Errorf("reply text cannot be empty")
+	}
+	path := fmt.Sprintf("posts/%s/comments/%s/reply", req.PostID, req.CommentID)
+	return c.do(ctx, "POST", path, req, resp)
+}
+
+// validateCommentID ensures a comment ID is safe to use in a URL path
+func validateCommentID(commentID string) error {
+	if commentID == "" {
+		return fmt.Errorf("comment ID cannot be empty")
+	}
+	if !postIDRegex.MatchString(commentID) {
+		return fmt.Errorf("comment ID must contain only alphanumeric characters, hyphens, and underscores")
+	}
+	return nil
+}
+
+// commentFetcher implements PageFetcher for post comments
+type commentFetcher struct {
+	client *Client
+	req    GetPostCommentsRequest
+}
+
+// FetchPage implements PageFetcher interface
+func (f *commentFetcher) FetchPage(ctx context.Context, pageNum int) (*Page[Comment], error) {
+	path := fmt.Sprintf("posts/%s/comments", f.req.PostID)
+	if pageNum > 1 {
+		path = fmt.Sprintf("%s?page=%d", path, pageNum)
+	}
+
+	if f.client.config.TolerantDecoding {
+		return fetchTolerantPage[Comment](ctx, f.client, path, "comments")
+	}
+
+	var resp GetPostCommentsResponse
+	if err := f.client.do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &Page[Comment]{
+		Items:      resp.Comments,
+		Total:      resp.Total,
+		Page:       resp.Page,
+		PerPage:    resp.PerPage,
+		TotalPages: resp.TotalPages,
+	}, nil
+}
+
+// UpdatePost updates an existing post
+func (c *Client) UpdatePost(ctx context.Context, req UpdatePostRequest, resp *UpdatePostResponse) error {
+	if err := validatePostID(req.PostID); err != nil {
+		return fmt.Errorf("invalid post ID: %w", err)
+	}
+	if req.Accounts != nil {
+		if len(req.Accounts) == 0 {
+			return fmt.Errorf("accounts cannot be empty when provided")
+		}
+		for _, accountID := range req.Accounts {
+			if !postIDRegex.MatchString(accountID) {
+				return fmt.Errorf("invalid account ID %q: must contain only alphanumeric characters, hyphens, and underscores", accountID)
+			}
+		}
+	}
+	c.warnMissingAltText(ctx, req.Media)
+	path := fmt.Sprintf("posts/%s", req.PostID)
+	return c.do(ctx, "PATCH", path, req, resp)
+}
+
+// PromoteDraft turns a draft into a post scheduled for scheduledAt, which must be in the future
+func (c *Client) PromoteDraft(ctx context.Context, postID string, scheduledAt time.Time) error {
+	if err := c.validateFutureTime(scheduledAt); err != nil {
+		return err
+	}
+
+	var resp UpdatePostResponse
+	return c.UpdatePost(ctx, UpdatePostRequest{
+		PostID:      postID,
+		State:       PostStateScheduled,
+		ScheduledAt: scheduledAt,
+	}, &resp)
+}
+
+// ReschedulePostBy moves a scheduled post by delta, which may be negative. The new
+// time must still be in the future.
+func (c *Client) ReschedulePostBy(ctx context.Context, postID string, delta time.Duration) error {
+	var post GetPostResponse
+	if err := c.GetPost(ctx, GetPostRequest{PostID: postID}, &post); err != nil {
+		return err
+	}
+	if post.ScheduledAt.IsZero() {
+		return fmt.Errorf("post %s has no scheduled time", postID)
+	}
+
+	scheduledAt := post.ScheduledAt.Add(delta)
+	if err := c.validateFutureTime(scheduledAt); err != nil {
+		return err
+	}
+
+	var resp UpdatePostResponse
+	return c.UpdatePost(ctx, UpdatePostRequest{PostID: postID, ScheduledAt: scheduledAt}, &resp)
+}
+
+// ShiftAccountSchedule moves every scheduled post of an account by delta, which may
+// be negative, and returns the IDs of the posts it moved. Posts that would land in
+// the past are skipped. Updates are sent one at a time and, as moving a post to a
+// given time is idempotent, rate limited updates are retried under Config.Retry. On
+// error the IDs moved so far are returned.
+func (c *Client) ShiftAccountSchedule(ctx context.Context, accountID string, delta time.Duration) ([]string, error) {
+	if accountID == "" {
+		return nil, fmt.Errorf("account ID cannot be empty")
+	}
+
+	posts, err := Collect(ctx, c.ListPosts(ctx, ListPostsRequest{
+		State:      PostStateScheduled,
+		AccountIDs: []string{accountID},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	updateCtx := WithRetrySafe(ctx)
+	var shifted []string
+	for _, post := range posts {
+		scheduledAt := post.ScheduledAt.Add(delta)
+		if post.ScheduledAt.IsZero() || c.validateFutureTime(scheduledAt) != nil {
+			continue
+		}
+
+		var resp UpdatePostResponse
+		req := UpdatePostRequest{PostID: post.ID, ScheduledAt: scheduledAt}
+		if err := c.UpdatePost(updateCtx, req, &resp); err != nil {
+			return shifted, fmt.Errorf("post %s: %w", post.ID, err)
+		}
+		shifted = append(shifted, post.ID)
+	}
+	return shifted, nil
+}
+
+// DeletePost deletes a post
+func (c *Client) DeletePost(ctx context.Context, req DeletePostRequest, resp *DeletePostResponse) error {
+	if err := validatePostID(req.PostID); err != nil {
+		return fmt.Errorf("invalid post ID: %w", err)
+	}
+	path := fmt.Sprintf("posts/%s", req.PostID)
+	return c.do(ctx, "DELETE", path, nil, resp)
+}
+
+// RestorePost moves a deleted post out of the trash
+func (c *Client) RestorePost(ctx context.Context, postID string) error {
+	if err := validatePostID(postID); err != nil {
+		return fmt.Errorf("invalid post ID: %w", err)
+	}
+	path := fmt.Sprintf("posts/%s/restore", postID)
+	return c.do(ctx, "POST", path, nil, nil)
+}
+
+// PinPost pins a published post to the top of its account's profile
+func (c *Client) PinPost(ctx context.Context, postID string) error {
+	return c.setPinned(ctx, postID, "pin")
+}
+
+// UnpinPost removes a post from the top of its account's profile
+func (c *Client) UnpinPost(ctx context.Context, postID string) error {
+	return c.setPinned(ctx, postID, "unpin")
+}
+
+// setPinned validates the post ID and sends a pin or unpin action
+func (c *Client) setPinned(ctx context.Context, postID string, action string) error {
+	if err := validatePostID(postID); err != nil {
+		return fmt.Errorf("invalid post ID: %w", err)
+	}
+	path := fmt.Sprintf("posts/%s/%s", postID, action)
+	return c.do(ctx, "POST", path, nil, nil)
+}
+
+// MovePostToWorkspace moves a post to another workspace. The post is looked up in the
+// client's workspace, identified by its Publer-Workspace-Id header; use WithHeader to
+// move a post out of a different workspace.
+func (c *Client) MovePostToWorkspace(ctx context.Context, postID, targetWorkspaceID string) error {
+	if err := validatePostID(postID); err != nil {
+		return fmt.Errorf("invalid post ID: %w", err)
+	}
+	if err := validateWorkspaceID(targetWorkspaceID); err != nil {
+		return fmt.Errorf("invalid workspace ID: %w", err)
+	}
+	path := fmt.Sprintf("posts/%s/move-workspace", postID)
+	return c.do(ctx, "POST", path, MovePostToWorkspaceRequest{WorkspaceID: targetWorkspaceID}, nil)
+}
+
+// AddLabels adds labels to each of the given posts
+func (c *Client) AddLabels(ctx context.Context, postIDs []string, labels []string) error {
+	return c.updateLabels(ctx, "add", postIDs, labels)
+}
+
+// RemoveLabels removes labels from each of the given posts
+func (c *Client) RemoveLabels(ctx context.Context, postIDs []string, labels []string) error {
+	return c.updateLabels(ctx, "remove", postIDs, labels)
+}
+
+// updateLabels validates and sends a bulk label change
+func (c *Client) updateLabels(ctx context.Context, action string, postIDs []string, labels []string) error {
+	if len(postIDs) == 0 {
+		return fmt.Errorf("at least one post ID is required")
+	}
+	if len(labels) == 0 {
+		return fmt.Errorf("at least one label is required")
+	}
+	for _, postID := range postIDs {
+		if err := validatePostID(postID); err != nil {
+			return fmt.Errorf("invalid post ID: %w", err)
+		}
+	}
+
+	req := UpdateLabelsRequest{
+		PostIDs: postIDs,
+		Labels:  labels,
+		Action:  action,
+	}
+	return c.do(ctx, "PATCH", "posts/labels", req, nil)
+}
+
+// ============================================================================
+// Post Listing Operations
+// ============================================================================
+
+// ListPosts retrieves posts with filtering options.
+// Posts are paged in order of ID; callers should not rely on insertion order.
+func (c *Client) ListPosts(ctx context.Context, request ListPostsRequest) Iterator[Post] {
+	return NewPostIterator(c, request)
+}
+
+// ============================================================================
+// Post Advanced Operations
+// ============================================================================
+
+// CreateRecurringPost creates a recurring post schedule
+func (c *Client) CreateRecurringPost(ctx context.Context, req RecurringPostRequest, resp *RecurringPostResponse) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	if err := c.requireFeature(ctx, FeatureRecurringPosts); err != nil {
+		return err
+	}
+	return c.do(ctx, "POST", "posts/recurring", req, resp)
+}
+
+// GetSeries returns the status of a recurring series created with CreateRecurringPost
+// and the posts generated for it
+func (c *Client) GetSeries(ctx context.Context, seriesID string) (Series, error) {
+	if err := validateSeriesID(seriesID); err != nil {
+		return Series{}, fmt.Errorf("invalid series ID: %w", err)
+	}
+
+	var series Series
+	if err := c.do(ctx, "GET", fmt.Sprintf("posts/recurring/%s", seriesID), nil, &series); err != nil {
+		return Series{}, err
+	}
+	return series, nil
+}
+
+// validateSeriesID ensures a series ID is safe to use in a URL path
+func validateSeriesID(seriesID string) error {
+	if seriesID == "" {
+		return fmt.Errorf("series ID cannot be empty")
+	}
+	if !postIDRegex.MatchString(seriesID) {
+		return fmt.Errorf("series ID must contain only alphanumeric characters, hyphens, and underscores")
+	}
+	return nil
 }
 
 // AutoSchedulePost uses AI to determine optimal posting times
 func (c *Client) AutoSchedulePost(ctx context.Context, req AutoScheduleRequest, resp *AutoScheduleResponse) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	if err := c.requireFeature(ctx, FeatureAutoSchedule); err != nil {
+		return err
+	}
 	return c.do(ctx, "POST", "posts/auto-schedule", req, resp)
 }
 
-// RecyclePost configures content recycling schedule
-func (c *Client) RecyclePost(ctx context.Context, req RecyclePostRequest, resp *RecyclePostResponse) error {
-	return c.do(ctx, "POST", "posts/recycle", req, resp)
+// RecyclePost configures content recycling schedule
+func (c *Client) RecyclePost(ctx context.Context, req RecyclePostRequest, resp *RecyclePostResponse) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	if err := c.requireFeature(ctx, FeatureRecycling); err != nil {
+		return err
+	}
+	return c.do(ctx, "POST", "posts/recycle", req, resp)
+}
+
+// ============================================================================
+// Post Convenience Operations
+// ============================================================================
+
+// GetPostsByState returns an iterator for posts filtered by state
+func (c *Client) GetPostsByState(state string) Iterator[Post] {
+	req := ListPostsRequest{
+		State: state,
+	}
+	return c.ListPosts(context.Background(), req)
+}
+
+// GetPostsByDateRange returns an iterator for posts within date range
+func (c *Client) GetPostsByDateRange(from, to time.Time) Iterator[Post] {
+	req := ListPostsRequest{
+		From: from,
+		To:   to,
+	}
+	return c.ListPosts(context.Background(), req)
+}
+
+// GetPostsByAccount returns posts for specific account
+func (c *Client) GetPostsByAccount(accountID string) Iterator[Post] {
+	req := ListPostsRequest{
+		AccountIDs: []string{accountID},
+	}
+	return c.ListPosts(context.Background(), req)
+}
+
+// GetPostsByQuery returns posts matching search query
+func (c *Client) GetPostsByQuery(query string) Iterator[Post] {
+	req := ListPostsRequest{
+		Query: query,
+	}
+	return c.ListPosts(context.Background(), req)
+}
+
+// validatePageNumber ensures single page requests use 1-based page numbers
+func validatePageNumber(page int) error {
+	if page < 1 {
+		return fmt.Errorf("page must be at least 1")
+	}
+	return nil
+}
+
+// PendingApprovals returns an iterator over posts waiting for a reviewer
+func (c *Client) PendingApprovals(ctx context.Context) Iterator[Post] {
+	if err := c.requireFeature(ctx, FeatureApprovals); err != nil {
+		return &errIterator[Post]{err: err}
+	}
+	req := ListPostsRequest{
+		State: PostStatePendingApproval,
+	}
+	return c.ListPosts(ctx, req)
+}
+
+// MemberQueue returns an iterator over the posts a team member has scheduled across
+// all accounts in the workspace
+func (c *Client) MemberQueue(ctx context.Context, memberID string) Iterator[Post] {
+	if err := validateMemberID(memberID); err != nil {
+		return &errIterator[Post]{err: fmt.Errorf("invalid member ID: %w", err)}
+	}
+	req := ListPostsRequest{
+		State:    PostStateScheduled,
+		MemberID: memberID,
+	}
+	return c.ListPosts(ctx, req)
+}
+
+// validateMemberID ensures a member ID is safe to send as a query parameter
+func validateMemberID(memberID string) error {
+	if memberID == "" {
+		return fmt.Errorf("member ID cannot be empty")
+	}
+	if !postIDRegex.MatchString(memberID) {
+		return fmt.Errorf("member ID must contain only alphanumeric characters, hyphens, and underscores")
+	}
+	return nil
+}
+
+// ExportPost gathers a post, its media and, once published, its analytics into a
+// single PostExport
+func (c *Client) ExportPost(ctx context.Context, postID string) (PostExport, error) {
+	var resp GetPostResponse
+	if err := c.GetPost(ctx, GetPostRequest{PostID: postID}, &resp); err != nil {
+		return PostExport{}, err
+	}
+
+	export := PostExport{Post: resp.Post, Media: resp.Media}
+	if export.Media == nil {
+		export.Media = []Media{}
+	}
+	if resp.State != PostStatePublished {
+		return export, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return PostExport{}, err
+	}
+	analytics, err := c.GetPostAnalytics(ctx, postID)
+	if err != nil {
+		return PostExport{}, fmt.Errorf("failed to get analytics: %w", err)
+	}
+	export.Analytics = &analytics
+	return export, nil
+}
+
+// ExportCalendar writes the scheduled posts matching req to w as an RFC 5545 iCalendar
+// stream with one event per post. Posts default to the scheduled state when req has no
+// state filter, and posts without a scheduled time are skipped. Times are written in UTC
+// so calendar apps convert them to the viewer's time zone.
+func (c *Client) ExportCalendar(ctx context.Context, req ListPostsRequest, w io.Writer) error {
+	if req.State == "" && len(req.States) == 0 {
+		req.State = PostStateScheduled
+	}
+
+	cw := &calendarWriter{w: w}
+	cw.line("BEGIN", "VCALENDAR")
+	cw.line("VERSION", "2.0")
+	cw.line("PRODID", "-//publer.go//Content Calendar//EN")
+	cw.line("CALSCALE", "GREGORIAN")
+
+	stamp := c.now()
+	iter := c.ListPosts(ctx, req)
+	for {
+		var page Page[Post]
+		more := iter.Next(ctx, &page)
+		if err := iter.Err(); err != nil {
+			return err
+		}
+		for _, post := range page.Items {
+			if !post.ScheduledAt.IsZero() {
+				cw.event(post, stamp)
+			}
+		}
+		if !more {
+			break
+		}
+	}
+
+	cw.line("END", "VCALENDAR")
+	return cw.err
+}
+
+// CalendarGrid groups the scheduled posts in [from, to) into slots of length bucket,
+// keyed by slot start in UTC. Slots are aligned to midnight in the time zone of each
+// post's scheduled time, so an hourly grid stays on the hour even in zones with a
+// fractional UTC offset.
+func (c *Client) CalendarGrid(ctx context.Context, from, to time.Time, bucket time.Duration) (map[time.Time][]Post, error) {
+	if bucket <= 0 {
+		return nil, fmt.Errorf("bucket must be positive")
+	}
+	if !from.Before(to) {
+		return nil, fmt.Errorf("from must be before to")
+	}
+
+	posts, err := Collect(ctx, c.ListPosts(ctx, ListPostsRequest{
+		State: PostStateScheduled,
+		From:  from,
+		To:    to,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	grid := make(map[time.Time][]Post)
+	for _, post := range posts {
+		at := post.ScheduledAt
+		if at.IsZero() || at.Before(from) || !at.Before(to) {
+			continue
+		}
+		midnight := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, at.Location())
+		slot := midnight.Add(at.Sub(midnight) / bucket * bucket)
+		// Decoded times each carry their own location, so key on UTC to let
+		// posts in the same slot share a bucket
+		grid[slot.UTC()] = append(grid[slot.UTC()], post)
+	}
+	return grid, nil
+}
+
+// PostStateCounts tallies the posts matching req by state in a single pass over the
+// listing. If listing fails part way, the counts gathered so far are returned with
+// the error.
+func (c *Client) PostStateCounts(ctx context.Context, req ListPostsRequest) (map[string]int, error) {
+	return tally(ctx, c.ListPosts(ctx, req), func(post Post) string { return post.State })
+}
+
+// ListPostsPage fetches a single page of posts matching the request filters
+func (c *Client) ListPostsPage(ctx context.Context, request ListPostsRequest, page int) (*Page[Post], error) {
+	if err := validatePageNumber(page); err != nil {
+		return nil, err
+	}
+	fetcher := &PostPageFetcher{client: c, request: request}
+	return fetcher.FetchPage(ctx, page)
+}
+
+// ============================================================================
+// Account Operations
+// ============================================================================
+
+// ListAccountsRequest represents request for listing accounts
+type ListAccountsRequest struct{}
+
+// ListAccountsResponse represents account list response
+type ListAccountsResponse struct {
+	Accounts   []Account `json:"accounts"`
+	Total      int       `json:"total"`
+	Page       int       `json:"page"`
+	PerPage    int       `json:"per_page"`
+	TotalPages int       `json:"total_pages"`
+}
+
+// accountFetcher implements PageFetcher for accounts
+type accountFetcher struct {
+	client *Client
+	req    ListAccountsRequest
+}
+
+// FetchPage implements PageFetcher interface
+func (f *accountFetcher) FetchPage(ctx context.Context, pageNum int) (*Page[Account], error) {
+	path := "accounts"
+	if pageNum > 1 {
+		path = fmt.Sprintf("accounts?page=%d", pageNum)
+	}
+
+	if f.client.config.TolerantDecoding {
+		return fetchTolerantPage[Account](ctx, f.client, path, "accounts")
+	}
+
+	var resp ListAccountsResponse
+	if err := f.client.do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &Page[Account]{
+		Items:      resp.Accounts,
+		Total:      resp.Total,
+		Page:       resp.Page,
+		PerPage:    resp.PerPage,
+		TotalPages: resp.TotalPages,
+	}, nil
+}
+
+// ListAccounts retrieves all social media accounts in the workspace.
+// Accounts are paged in order of ID; callers should not rely on insertion order.
+func (c *Client) ListAccounts(ctx context.Context, req ListAccountsRequest) Iterator[Account] {
+	fetcher := &accountFetcher{
+		client: c,
+		req:    req,
+	}
+	return NewGenericIterator[Account](fetcher)
+}
+
+// MyPostableAccounts returns the accounts the current user has permission to post to.
+// Accounts without an AccountPermissionPost permission are excluded.
+func (c *Client) MyPostableAccounts(ctx context.Context) ([]Account, error) {
+	accounts, err := Collect(ctx, c.ListAccounts(ctx, ListAccountsRequest{}))
+	if err != nil {
+		return nil, err
+	}
+
+	var postable []Account
+	for _, account := range accounts {
+		if slices.Contains(account.Permissions, AccountPermissionPost) {
+			postable = append(postable, account)
+		}
+	}
+	return postable, nil
+}
+
+// AccountCountsByProvider tallies the connected accounts by provider. If listing fails
+// or ctx is cancelled part way, the counts gathered so far are returned with the error.
+func (c *Client) AccountCountsByProvider(ctx context.Context) (map[string]int, error) {
+	return tally(ctx, c.ListAccounts(ctx, ListAccountsRequest{}), func(account Account) string { return account.Provider })
+}
+
+// AccountQuota reports how many social accounts are connected and how many the plan
+// allows. A limit of 0 means the plan has no account limit.
+func (c *Client) AccountQuota(ctx context.Context) (used, limit int, err error) {
+	accounts, err := Collect(ctx, c.ListAccounts(ctx, ListAccountsRequest{}))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var me GetMeResponse
+	if err := c.GetMe(ctx, GetMeRequest{}, &me); err != nil {
+		return 0, 0, err
+	}
+	return len(accounts), me.AccountLimit, nil
+}
+
+// validateAccountID ensures an account ID is safe to use in a URL path
+func validateAccountID(accountID string) error {
+	if accountID == "" {
+		return fmt.Errorf("account ID cannot be empty")
+	}
+	if !postIDRegex.MatchString(accountID) {
+		return fmt.Errorf("account ID must contain only alphanumeric characters, hyphens, and underscores")
+	}
+	return nil
+}
+
+// BestTimesResponse represents the recommended posting times for an account
+type BestTimesResponse struct {
+	Slots []TimeSlot `json:"slots"`
+}
+
+// BestTimes retrieves the weekly time slots with the best expected engagement for an
+// account. These are the slots AutoSchedulePost chooses from.
+func (c *Client) BestTimes(ctx context.Context, accountID string) ([]TimeSlot, error) {
+	if err := validateAccountID(accountID); err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	var resp BestTimesResponse
+	path := fmt.Sprintf("accounts/%s/best-times", accountID)
+	if err := c.do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Slots, nil
+}
+
+// AccountReconnectURLResponse contains the URL a user visits to reconnect an account
+type AccountReconnectURLResponse struct {
+	URL string `json:"url"`
+}
+
+// GetAccountReconnectURL returns the URL where a user re-authorizes an account whose
+// connection has expired. The API responds with a conflict for active accounts.
+func (c *Client) GetAccountReconnectURL(ctx context.Context, accountID string) (string, error) {
+	if err := validateAccountID(accountID); err != nil {
+		return "", fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	var resp AccountReconnectURLResponse
+	path := fmt.Sprintf("accounts/%s/reconnect_url", accountID)
+	if err := c.do(ctx, "GET", path, nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.URL, nil
+}
+
+// ListAccountsPage fetches a single page of accounts
+func (c *Client) ListAccountsPage(ctx context.Context, page int) (*Page[Account], error) {
+	if err := validatePageNumber(page); err != nil {
+		return nil, err
+	}
+	fetcher := &accountFetcher{client: c}
+	return fetcher.FetchPage(ctx, page)
+}
+
+// ============================================================================
+// User Operations
+// ============================================================================
+
+// GetMeRequest represents request for current user
+type GetMeRequest struct{}
+
+// GetMeResponse represents current user response
+type GetMeResponse struct {
+	User
+
+	// AccountLimit is how many social accounts the plan allows, 0 when unlimited
+	AccountLimit int `json:"account_limit,omitempty"`
+}
+
+// GetMe retrieves information about the currently authenticated user
+func (c *Client) GetMe(ctx context.Context, req GetMeRequest, resp *GetMeResponse) error {
+	return c.do(ctx, "GET", "users/me", nil, resp)
+}
+
+// UsageResponse reports the workspace's consumption of daily plan quotas
+type UsageResponse struct {
+	DailyPostsUsed  int       `json:"daily_posts_used"`
+	DailyPostsLimit int       `json:"daily_posts_limit"` // 0 when the plan has no daily cap
+	ResetsAt        time.Time `json:"resets_at"`
+	BulkPostsLimit  int       `json:"bulk_posts_limit"` // most posts per bulk request, 0 when unlimited
+}
+
+// DailyPostBudget reports how many posts were created today, the plan's daily cap and
+// when the count resets. A limit of 0 means the plan has no daily cap. This is separate
+// from the per-request rate limit.
+func (c *Client) DailyPostBudget(ctx context.Context) (used, limit int, resetsAt time.Time, err error) {
+	var resp UsageResponse
+	if err := c.do(ctx, "GET", "users/me/usage", nil, &resp); err != nil {
+		return 0, 0, time.Time{}, err
+	}
+	return resp.DailyPostsUsed, resp.DailyPostsLimit, resp.ResetsAt, nil
+}
+
+// ============================================================================
+// Workspace Operations
+// ============================================================================
+
+// validateWorkspaceID ensures a workspace ID is safe to send as a header
+func validateWorkspaceID(workspaceID string) error {
+	if workspaceID == "" {
+		return fmt.Errorf("workspace ID cannot be empty")
+	}
+	if !postIDRegex.MatchString(workspaceID) {
+		return fmt.Errorf("workspace ID must contain only alphanumeric characters, hyphens, and underscores")
+	}
+	return nil
+}
+
+// WithWorkspace returns a client for another workspace that shares this client's
+// HTTP client, configuration, rate limiter and caches, so connections are reused
+// when fanning out across workspaces. Plan features are fetched separately for the
+// new workspace. The original client is unchanged. When workspaceID is invalid every
+// request made with the returned client fails with the validation error.
+func (c *Client) WithWorkspace(workspaceID string) *Client {
+	scoped := *c
+	scoped.config.WorkspaceID = workspaceID
+	scoped.features = &featureCache{}
+	if err := validateWorkspaceID(workspaceID); err != nil {
+		scoped.err = fmt.Errorf("invalid workspace ID: %w", err)
+	}
+	return &scoped
+}
+
+// ListPostsInWorkspace lists posts in another workspace without changing the client.
+// Every page request carries workspaceID as its Publer-Workspace-Id header.
+func (c *Client) ListPostsInWorkspace(ctx context.Context, workspaceID string, req ListPostsRequest) Iterator[Post] {
+	if err := validateWorkspaceID(workspaceID); err != nil {
+		return &errIterator[Post]{err: fmt.Errorf("invalid workspace ID: %w", err)}
+	}
+	return NewGenericIterator[Post](&headerFetcher[Post]{
+		fetcher: &PostPageFetcher{client: c, request: req},
+		name:    "Publer-Workspace-Id",
+		value:   workspaceID,
+	})
+}
+
+// ListAccountsInWorkspace lists the accounts of another workspace without changing the client
+func (c *Client) ListAccountsInWorkspace(ctx context.Context, workspaceID string, req ListAccountsRequest) Iterator[Account] {
+	if err := validateWorkspaceID(workspaceID); err != nil {
+		return &errIterator[Account]{err: fmt.Errorf("invalid workspace ID: %w", err)}
+	}
+	return NewGenericIterator[Account](&headerFetcher[Account]{
+		fetcher: &accountFetcher{client: c, req: req},
+		name:    "Publer-Workspace-Id",
+		value:   workspaceID,
+	})
+}
+
+// ListWorkspacesRequest represents request for listing workspaces
+type ListWorkspacesRequest struct{}
+
+// ListWorkspacesResponse represents workspace list response
+type ListWorkspacesResponse struct {
+	Workspaces []Workspace `json:"workspaces"`
+	Total      int         `json:"total"`
+	Page       int         `json:"page"`
+	PerPage    int         `json:"per_page"`
+	TotalPages int         `json:"total_pages"`
+}
+
+// workspacePageFetcher implements PageFetcher for workspaces
+type workspacePageFetcher struct {
+	client *Client
+}
+
+// FetchPage fetches a page of workspaces
+func (f *workspacePageFetcher) FetchPage(ctx context.Context, pageNum int) (*Page[Workspace], error) {
+	path := "workspaces"
+	if pageNum > 1 {
+		path = fmt.Sprintf("workspaces?page=%s", strconv.Itoa(pageNum))
+	}
+
+	if f.client.config.TolerantDecoding {
+		return fetchTolerantPage[Workspace](ctx, f.client, path, "workspaces")
+	}
+
+	var resp ListWorkspacesResponse
+	if err := f.client.do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &Page[Workspace]{
+		Items:      resp.Workspaces,
+		Total:      resp.Total,
+		Page:       resp.Page,
+		PerPage:    resp.PerPage,
+		TotalPages: resp.TotalPages,
+	}, nil
+}
+
+// ListWorkspaces retrieves all workspaces for the authenticated user
+func (c *Client) ListWorkspaces(ctx context.Context, req ListWorkspacesRequest) Iterator[Workspace] {
+	fetcher := &workspacePageFetcher{client: c}
+	return NewGenericIterator(fetcher)
+}
+
+// ListWorkspacesPage fetches a single page of workspaces
+func (c *Client) ListWorkspacesPage(ctx context.Context, page int) (*Page[Workspace], error) {
+	if err := validatePageNumber(page); err != nil {
+		return nil, err
+	}
+	fetcher := &workspacePageFetcher{client: c}
+	return fetcher.FetchPage(ctx, page)
+}
+
+// WorkspaceStats retrieves post counts by state and network for the current workspace
+func (c *Client) WorkspaceStats(ctx context.Context) (WorkspaceStats, error) {
+	var stats WorkspaceStats
+	if err := c.do(ctx, "GET", "workspaces/stats", nil, &stats); err != nil {
+		return WorkspaceStats{}, err
+	}
+	return stats, nil
+}
+
+// ============================================================================
+// Template Operations
+// ============================================================================
+
+// validateTemplateID ensures a template ID is safe to use in a URL path
+func validateTemplateID(templateID string) error {
+	if templateID == "" {
+		return fmt.Errorf("template ID cannot be empty")
+	}
+	if !postIDRegex.MatchString(templateID) {
+		return fmt.Errorf("template ID must contain only alphanumeric characters, hyphens, and underscores")
+	}
+	return nil
+}
+
+// templateFetcher implements PageFetcher for templates
+type templateFetcher struct {
+	client *Client
+}
+
+// FetchPage fetches a page of templates
+func (f *templateFetcher) FetchPage(ctx context.Context, pageNum int) (*Page[Template], error) {
+	path := "templates"
+	if pageNum > 1 {
+		path = fmt.Sprintf("templates?page=%d", pageNum)
+	}
+
+	if f.client.config.TolerantDecoding {
+		return fetchTolerantPage[Template](ctx, f.client, path, "templates")
+	}
+
+	var resp ListTemplatesResponse
+	if err := f.client.do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &Page[Template]{
+		Items:      resp.Templates,
+		Total:      resp.Total,
+		Page:       resp.Page,
+		PerPage:    resp.PerPage,
+		TotalPages: resp.TotalPages,
+	}, nil
+}
+
+// ListTemplates retrieves all saved post templates in the workspace
+func (c *Client) ListTemplates(ctx context.Context) Iterator[Template] {
+	return NewGenericIterator[Template](&templateFetcher{client: c})
+}
+
+// GetTemplate retrieves a single saved post template
+func (c *Client) GetTemplate(ctx context.Context, templateID string, resp *GetTemplateResponse) error {
+	if err := validateTemplateID(templateID); err != nil {
+		return fmt.Errorf("invalid template ID: %w", err)
+	}
+	path := fmt.Sprintf("templates/%s", templateID)
+	return c.do(ctx, "GET", path, nil, resp)
+}
+
+// CreateTemplate saves reusable post content as a template
+func (c *Client) CreateTemplate(ctx context.Context, req CreateTemplateRequest, resp *CreateTemplateResponse) error {
+	if strings.TrimSpace(req.Name) == "" {
+		return fmt.Errorf("template name is required")
+	}
+	if strings.TrimSpace(req.Text) == "" {
+		return fmt.Errorf("template text is required")
+	}
+	return c.do(ctx, "POST", "templates", req, resp)
+}
+
+// DeleteTemplate removes a saved post template
+func (c *Client) DeleteTemplate(ctx context.Context, templateID string) error {
+	if err := validateTemplateID(templateID); err != nil {
+		return fmt.Errorf("invalid template ID: %w", err)
+	}
+	path := fmt.Sprintf("templates/%s", templateID)
+	return c.do(ctx, "DELETE", path, nil, nil)
+}
+
+// PublishFromTemplate publishes the text, media and labels of a saved template to the
+// given accounts immediately
+func (c *Client) PublishFromTemplate(ctx context.Context, templateID string, accounts []string) error {
+	var template GetTemplateResponse
+	if err := c.GetTemplate(ctx, templateID, &template); err != nil {
+		return err
+	}
+
+	return c.Publish(ctx, PublishRequest{
+		Text:     template.Text,
+		Accounts: accounts,
+		Media:    template.Media,
+		Labels:   template.Labels,
+	}, &PublishResponse{})
+}
+
+// ============================================================================
+// Webhook Operations
+// ============================================================================
+
+// validateWebhookID ensures a webhook ID is safe to use in a URL path
+func validateWebhookID(webhookID string) error {
+	if webhookID == "" {
+		return fmt.Errorf("webhook ID cannot be empty")
+	}
+	if !postIDRegex.MatchString(webhookID) {
+		return fmt.Errorf("webhook ID must contain only alphanumeric characters, hyphens, and underscores")
+	}
+	return nil
+}
+
+// webhookFetcher implements PageFetcher for webhook subscriptions
+type webhookFetcher struct {
+	client *Client
+}
+
+// FetchPage fetches a page of webhook subscriptions
+func (f *webhookFetcher) FetchPage(ctx context.Context, pageNum int) (*Page[Webhook], error) {
+	path := "webhooks"
+	if pageNum > 1 {
+		path = fmt.Sprintf("webhooks?page=%d", pageNum)
+	}
+
+	if f.client.config.TolerantDecoding {
+		return fetchTolerantPage[Webhook](ctx, f.client, path, "webhooks")
+	}
+
+	var resp ListWebhooksResponse
+	if err := f.client.do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &Page[Webhook]{
+		Items:      resp.Webhooks,
+		Total:      resp.Total,
+		Page:       resp.Page,
+		PerPage:    resp.PerPage,
+		TotalPages: resp.TotalPages,
+	}, nil
+}
+
+// ListWebhooks retrieves the webhook subscriptions of the workspace
+func (c *Client) ListWebhooks(ctx context.Context) Iterator[Webhook] {
+	return NewGenericIterator[Webhook](&webhookFetcher{client: c})
+}
+
+// CreateWebhook subscribes an https endpoint to the given events. The response
+// includes the secret used to sign deliveries.
+func (c *Client) CreateWebhook(ctx context.Context, req CreateWebhookRequest, resp *CreateWebhookResponse) error {
+	endpoint, err := url.Parse(req.URL)
+	if err != nil || endpoint.Host == "" {
+		return fmt.Errorf("invalid webhook URL %q", req.URL)
+	}
+	if endpoint.Scheme != "https" {
+		return fmt.Errorf("webhook URL must use https")
+	}
+	if len(req.Events) == 0 {
+		return fmt.Errorf("at least one webhook event is required")
+	}
+	for i, event := range req.Events {
+		if strings.TrimSpace(event) == "" {
+			return fmt.Errorf("webhook event %d cannot be empty", i+1)
+		}
+	}
+	return c.do(ctx, "POST", "webhooks", req, resp)
+}
+
+// DeleteWebhook removes a webhook subscription
+func (c *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
+	if err := validateWebhookID(webhookID); err != nil {
+		return fmt.Errorf("invalid webhook ID: %w", err)
+	}
+	path := fmt.Sprintf("webhooks/%s", webhookID)
+	return c.do(ctx, "DELETE", path, nil, nil)
+}
+
+// ============================================================================
+// Media Operations
+// ============================================================================
+
+// UploadMedia uploads a media file as multipart form data so it can be attached to posts
+func (c *Client) UploadMedia(ctx context.Context, req UploadMediaRequest, resp *UploadMediaResponse) error {
+	if req.Filename == "" {
+		return fmt.Errorf("filename is required")
+	}
+	if req.Content == nil {
+		return fmt.Errorf("content is required")
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	part, err := writer.CreateFormFile("file", req.Filename)
+	if err != nil {
+		return fmt.Errorf("failed to create form file: %w", err)
+	}
+	if _, err := io.Copy(part, req.Content); err != nil {
+		return fmt.Errorf("failed to read media content: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to encode multipart body: %w", err)
+	}
+
+	return c.doRaw(ctx, "POST", "media", writer.FormDataContentType(), buf.Bytes(), resp)
 }
 
 // ============================================================================
-// Post Convenience Operations
+// Metadata Operations
 // ============================================================================
 
-// GetPostsByState returns an iterator for posts filtered by state
-func (c *Client) GetPostsByState(state string) Iterator[Post] {
-	req := ListPostsRequest{
-		State: state,
-	}
-	return c.ListPosts(context.Background(), req)
+// ListNetworksResponse represents supported network list response
+type ListNetworksResponse struct {
+	Networks []NetworkInfo `json:"networks"`
 }
 
-// GetPostsByDateRange returns an iterator for posts within date range
-func (c *Client) GetPostsByDateRange(from, to time.Time) Iterator[Post] {
-	req := ListPostsRequest{
-		From: from,
-		To:   to,
+// SupportedNetworks retrieves the limits of each supported network. The result is
+// cached on the client after the first successful call.
+func (c *Client) SupportedNetworks(ctx context.Context) ([]NetworkInfo, error) {
+	c.networks.mu.Lock()
+	defer c.networks.mu.Unlock()
+
+	if c.networks.networks != nil {
+		return cloneNetworks(c.networks.networks), nil
 	}
-	return c.ListPosts(context.Background(), req)
-}
 
-// GetPostsByAccount returns posts for specific account
-func (c *Client) GetPostsByAccount(accountID string) Iterator[Post] {
-	req := ListPostsRequest{
-		AccountIDs: []string{accountID},
+	var resp ListNetworksResponse
+	if err := c.do(ctx, "GET", "networks", nil, &resp); err != nil {
+		return nil, err
 	}
-	return c.ListPosts(context.Background(), req)
+
+	c.networks.networks = resp.Networks
+	return cloneNetworks(resp.Networks), nil
 }
 
-// GetPostsByQuery returns posts matching search query
-func (c *Client) GetPostsByQuery(query string) Iterator[Post] {
-	req := ListPostsRequest{
-		Query: query,
+// cloneNetworks deep copies networks so callers cannot change the cached slices
+func cloneNetworks(networks []NetworkInfo) []NetworkInfo {
+	cloned := slices.Clone(networks)
+	for i := range cloned {
+		cloned[i].MediaTypes = slices.Clone(cloned[i].MediaTypes)
 	}
-	return c.ListPosts(context.Background(), req)
+	return cloned
 }
 
-// ============================================================================
-// Account Operations
-// ============================================================================
+// Diagnostics reports the health of the connection to the API
+type Diagnostics struct {
+	Reachable     bool          // an HTTP response was received
+	Latency       time.Duration // round trip time of the probe request
+	RateRemaining int           // requests left in the rate limit window, -1 when not reported
+}
 
-// ListAccountsRequest represents request for listing accounts
-type ListAccountsRequest struct{}
+// Diagnose times a lightweight authenticated request to check the API is reachable.
+// Reachable is true whenever the API answered, even if the request itself failed, in
+// which case the error is returned alongside the diagnostics.
+func (c *Client) Diagnose(ctx context.Context) (Diagnostics, error) {
+	// Read the rate limit from the probe's own response, as the client-wide state is
+	// overwritten by whichever concurrent request finished last
+	meta := responseMetaFromContext(ctx)
+	if meta == nil {
+		meta = &ResponseMeta{}
+		ctx = WithResponseMeta(ctx, meta)
+	}
 
-// ListAccountsResponse represents account list response
-type ListAccountsResponse struct {
-	Accounts   []Account `json:"accounts"`
-	Total      int       `json:"total"`
-	Page       int       `json:"page"`
-	PerPage    int       `json:"per_page"`
-	TotalPages int       `json:"total_pages"`
+	start := time.Now()
+	var resp GetMeResponse
+	err := c.GetMe(ctx, GetMeRequest{}, &resp)
+
+	diag := Diagnostics{
+		Latency:       time.Since(start),
+		RateRemaining: -1,
+	}
+	var apiErr *APIError
+	if err == nil || errors.As(err, &apiErr) {
+		diag.Reachable = true
+		diag.RateRemaining = remainingFromHeader(meta.Header)
+	}
+	return diag, err
 }
 
-// accountFetcher implements PageFetcher for accounts
-type accountFetcher struct {
-	client *Client
-	req    ListAccountsRequest
+// FeaturesResponse lists the features of the workspace plan
+type FeaturesResponse struct {
+	Features map[string]bool `json:"features"`
 }
 
-// FetchPage implements PageFetcher interface
-func (f *accountFetcher) FetchPage(ctx context.Context, pageNum int) (*Page[Account], error) {
-	path := "accounts"
-	if pageNum > 1 {
-		path = fmt.Sprintf("accounts?page=%d", pageNum)
+// Features retrieves which features the workspace plan includes, keyed by names such as
+// FeatureRecurringPosts. The result is cached on the client after the first successful call.
+func (c *Client) Features(ctx context.Context) (map[string]bool, error) {
+	c.features.mu.Lock()
+	defer c.features.mu.Unlock()
+
+	if c.features.features != nil {
+		return maps.Clone(c.features.features), nil
 	}
 
-	var resp ListAccountsResponse
-	if err := f.client.do(ctx, "GET", path, nil, &resp); err != nil {
+	var resp FeaturesResponse
+	if err := c.do(ctx, "GET", "features", nil, &resp); err != nil {
 		return nil, err
 	}
+	if resp.Features == nil {
+		resp.Features = make(map[string]bool)
+	}
 
-	return &Page[Account]{
-		Items:      resp.Accounts,
-		Total:      resp.Total,
-		Page:       resp.Page,
-		PerPage:    resp.PerPage,
-		TotalPages: resp.TotalPages,
-	}, nil
+	c.features.features = resp.Features
+	return maps.Clone(resp.Features), nil
 }
 
-// ListAccounts retrieves all social media accounts in the workspace
-func (c *Client) ListAccounts(ctx context.Context, req ListAccountsRequest) Iterator[Account] {
-	fetcher := &accountFetcher{
-		client: c,
-		req:    req,
+// requireFeature returns ErrFeatureNotAvailable when Config.CheckFeatures is enabled
+// and the plan lacks feature
+func (c *Client) requireFeature(ctx context.Context, feature string) error {
+	if !c.config.CheckFeatures {
+		return nil
 	}
-	return NewGenericIterator[Account](fetcher)
+
+	features, err := c.Features(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check plan features: %w", err)
+	}
+	if !features[feature] {
+		return fmt.Errorf("%w: %s", ErrFeatureNotAvailable, feature)
+	}
+	return nil
 }
 
 // ============================================================================
-// User Operations
+// Batch Operations
 // ============================================================================
 
-// GetMeRequest represents request for current user
-type GetMeRequest struct{}
+// Batch runs independent operations with at most concurrency running at once and
+// returns their errors positionally. When an operation fails with a RateLimitError
+// carrying a reset time, no further operations start until that time. Operations not
+// started before ctx is cancelled report ctx.Err().
+func (c *Client) Batch(ctx context.Context, ops []func(context.Context) error, concurrency int) []error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
 
-// GetMeResponse represents current user response
-type GetMeResponse struct {
-	User
-}
+	errs := make([]error, len(ops))
+	sem := make(chan struct{}, concurrency)
+	pause := &batchPause{}
 
-// GetMe retrieves information about the currently authenticated user
-func (c *Client) GetMe(ctx context.Context, req GetMeRequest, resp *GetMeResponse) error {
-	return c.do(ctx, "GET", "users/me", nil, resp)
+	var wg sync.WaitGroup
+	for i, op := range ops {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			for j := i; j < len(ops); j++ {
+				errs[j] = err
+			}
+			break
+		}
+		if err := pause.wait(ctx); err != nil {
+			<-sem
+			for j := i; j < len(ops); j++ {
+				errs[j] = err
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = op(ctx)
+			var rateLimitErr *RateLimitError
+			if errors.As(errs[i], &rateLimitErr) && rateLimitErr.Reset > 0 {
+				pause.until(time.Unix(rateLimitErr.Reset, 0))
+			}
+		}()
+	}
+	wg.Wait()
+	return errs
 }
 
-// ============================================================================
-// Workspace Operations
-// ============================================================================
+// SuggestChunkSize returns how many posts to send per request when splitting
+// totalPosts across several BulkPublish or BulkSchedule calls. It uses as few requests
+// as the plan's bulk limit allows and spreads the posts evenly across them, so the
+// last chunk is not a small remainder. When the rate limit window has fewer requests
+// remaining than chunks needed, the suggestion is returned along with an error.
+func (c *Client) SuggestChunkSize(ctx context.Context, totalPosts int) (int, error) {
+	if totalPosts < 1 {
+		return 0, fmt.Errorf("total posts must be positive, got %d", totalPosts)
+	}
 
-// ListWorkspacesRequest represents request for listing workspaces
-type ListWorkspacesRequest struct{}
+	// Read the rate limit from this response, as the client-wide state is overwritten
+	// by whichever concurrent request finished last
+	meta := responseMetaFromContext(ctx)
+	if meta == nil {
+		meta = &ResponseMeta{}
+		ctx = WithResponseMeta(ctx, meta)
+	}
 
-// ListWorkspacesResponse represents workspace list response
-type ListWorkspacesResponse struct {
-	Workspaces []Workspace `json:"workspaces"`
-	Total      int         `json:"total"`
-	Page       int         `json:"page"`
-	PerPage    int         `json:"per_page"`
-	TotalPages int         `json:"total_pages"`
-}
+	var usage UsageResponse
+	if err := c.do(ctx, "GET", "users/me/usage", nil, &usage); err != nil {
+		return 0, err
+	}
 
-// workspacePageFetcher implements PageFetcher for workspaces
-type workspacePageFetcher struct {
-	client *Client
-}
+	limit := usage.BulkPostsLimit
+	if limit <= 0 || limit > totalPosts {
+		limit = totalPosts
+	}
+	chunks := (totalPosts + limit - 1) / limit
+	size := (totalPosts + chunks - 1) / chunks
 
-// FetchPage fetches a page of workspaces
-func (f *workspacePageFetcher) FetchPage(ctx context.Context, pageNum int) (*Page[Workspace], error) {
-	path := "workspaces"
-	if pageNum > 1 {
-		path = fmt.Sprintf("workspaces?page=%s", strconv.Itoa(pageNum))
+	if remaining := remainingFromHeader(meta.Header); remaining >= 0 && remaining < chunks {
+		return size, fmt.Errorf("%d chunks are needed but only %d requests remain in the rate limit window", chunks, remaining)
 	}
+	return size, nil
+}
 
-	var resp ListWorkspacesResponse
-	if err := f.client.do(ctx, "GET", path, nil, &resp); err != nil {
-		return nil, err
+// remainingFromHeader returns the X-RateLimit-Remaining value of header, -1 when absent
+func remainingFromHeader(header http.Header) int {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return -1
 	}
+	return remaining
+}
 
-	return &Page[Workspace]{
-		Items:      resp.Workspaces,
-		Total:      resp.Total,
-		Page:       resp.Page,
-		PerPage:    resp.PerPage,
-		TotalPages: resp.TotalPages,
-	}, nil
+// batchPause holds back Batch operations until a rate limit window resets
+type batchPause struct {
+	mu    sync.Mutex
+	after time.Time
 }
 
-// ListWorkspaces retrieves all workspaces for the authenticated user
-func (c *Client) ListWorkspaces(ctx context.Context, req ListWorkspacesRequest) Iterator[Workspace] {
-	fetcher := &workspacePageFetcher{client: c}
-	return NewGenericIterator(fetcher)
+// until delays future operations to at least t
+func (p *batchPause) until(t time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if t.After(p.after) {
+		p.after = t
+	}
+}
+
+// wait blocks until the pause has passed or ctx is cancelled
+func (p *batchPause) wait(ctx context.Context) error {
+	p.mu.Lock()
+	delay := time.Until(p.after)
+	p.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // ============================================================================
@@ -482,16 +2566,24 @@ type WaitOptions struct {
 	InitialDelay time.Duration
 	MaxDelay     time.Duration
 	Jitter       time.Duration
-}
 
-// GetJobStatus checks status of async job
-func (c *Client) GetJobStatus(ctx context.Context, req GetJobStatusRequest, resp *GetJobStatusResponse) error {
-	path := fmt.Sprintf("job_status/%s", req.JobID)
-	return c.do(ctx, "GET", path, nil, resp)
+	// MaxConsecutiveErrors is how many transient polling failures in a row WaitForJob
+	// tolerates before giving up (defaults to 3)
+	MaxConsecutiveErrors int
+
+	// Schedule, when set, returns the delay before each poll given the 1-based poll
+	// attempt and the previous delay (0 before the first poll). It replaces the default
+	// schedule, which starts at InitialDelay and doubles up to MaxDelay with random
+	// Jitter, so those fields are ignored.
+	Schedule func(attempt int, last time.Duration) time.Duration
 }
 
-// WaitForJob polls job status until completion with configurable timing
-func (c *Client) WaitForJob(ctx context.Context, opts WaitOptions, result *JobResult) error {
+// pollSchedule returns opts.Schedule, or the default backoff schedule when it is unset
+func (opts WaitOptions) pollSchedule() func(attempt int, last time.Duration) time.Duration {
+	if opts.Schedule != nil {
+		return opts.Schedule
+	}
+
 	initialDelay := opts.InitialDelay
 	if initialDelay == 0 {
 		initialDelay = time.Second
@@ -504,8 +2596,142 @@ func (c *Client) WaitForJob(ctx context.Context, opts WaitOptions, result *JobRe
 	if jitter == 0 {
 		jitter = 500 * time.Millisecond
 	}
+	return func(attempt int, last time.Duration) time.Duration {
+		if attempt <= 1 {
+			return initialDelay
+		}
+		return nextPollDelay(last, maxDelay, jitter)
+	}
+}
+
+// GetJobStatus checks status of async job
+func (c *Client) GetJobStatus(ctx context.Context, req GetJobStatusRequest, resp *GetJobStatusResponse) error {
+	path := fmt.Sprintf("job_status/%s", req.JobID)
+	return c.do(ctx, "GET", path, nil, resp)
+}
+
+// WaitForJob polls job status until completion with configurable timing
+func (c *Client) WaitForJob(ctx context.Context, opts WaitOptions, result *JobResult) error {
+	return c.pollJob(ctx, opts, result, nil)
+}
+
+// WatchJob polls job status like WaitForJob, streaming every status it observes on the
+// returned status channel. When the job finishes the status channel is closed and the
+// terminal error (nil on success) is sent on the error channel. Cancelling ctx stops polling.
+func (c *Client) WatchJob(ctx context.Context, opts WaitOptions) (<-chan JobStatus, <-chan error) {
+	statuses := make(chan JobStatus)
+	errs := make(chan error, 1)
+
+	go func() {
+		var result JobResult
+		err := c.pollJob(ctx, opts, &result, func(status JobStatus) bool {
+			select {
+			case statuses <- status:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+		close(statuses)
+		errs <- err
+		close(errs)
+	}()
+
+	return statuses, errs
+}
+
+const (
+	// waitForPostsConcurrency bounds the number of in-flight GetPost calls when
+	// fetching the posts created by a job
+	waitForPostsConcurrency = 4
+	// waitForPostsInterval is the minimum spacing between those GetPost calls
+	waitForPostsInterval = 50 * time.Millisecond
+)
+
+// WaitForPosts waits for the job to complete and fetches the posts it created, in the
+// order of JobResult.PostIDs. If the job fails its error is returned with no posts.
+func (c *Client) WaitForPosts(ctx context.Context, opts WaitOptions) ([]Post, error) {
+	var result JobResult
+	if err := c.WaitForJob(ctx, opts, &result); err != nil {
+		return nil, err
+	}
+	return c.getPostsByIDs(ctx, result.PostIDs)
+}
+
+// BulkResultPosts fetches the posts created by a completed job, such as a bulk publish,
+// in the order of JobResult.PostIDs. It does not wait; a job that has not completed
+// yet is an error.
+func (c *Client) BulkResultPosts(ctx context.Context, jobID string) ([]Post, error) {
+	var resp GetJobStatusResponse
+	if err := c.GetJobStatus(ctx, GetJobStatusRequest{JobID: jobID}, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != "completed" {
+		return nil, fmt.Errorf("job %s is not complete: status %s", jobID, resp.Status)
+	}
+	if resp.Result == nil {
+		return []Post{}, nil
+	}
+	return c.getPostsByIDs(ctx, resp.Result.PostIDs)
+}
+
+// getPostsByIDs fetches posts concurrently, bounded by waitForPostsConcurrency and
+// spaced by waitForPostsInterval, returning them in the order of postIDs. Fetch
+// failures are joined into a single error.
+func (c *Client) getPostsByIDs(ctx context.Context, postIDs []string) ([]Post, error) {
+	posts := make([]Post, len(postIDs))
+	errs := make([]error, len(postIDs))
+	sem := make(chan struct{}, waitForPostsConcurrency)
+	limiter := time.NewTicker(waitForPostsInterval)
+	defer limiter.Stop()
+
+	var wg sync.WaitGroup
+	for i, postID := range postIDs {
+		if i > 0 {
+			select {
+			case <-limiter.C:
+			case <-ctx.Done():
+				wg.Wait()
+				return nil, ctx.Err()
+			}
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			var resp GetPostResponse
+			if err := c.GetPost(ctx, GetPostRequest{PostID: postID}, &resp); err != nil {
+				errs[i] = fmt.Errorf("post %s: %w", postID, err)
+				return
+			}
+			posts[i] = resp.Post
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// pollJob implements WaitForJob, calling onStatus (when not nil) with every status
+// received. Polling stops with ctx.Err() if onStatus returns false.
+func (c *Client) pollJob(ctx context.Context, opts WaitOptions, result *JobResult, onStatus func(JobStatus) bool) error {
+	maxErrors := opts.MaxConsecutiveErrors
+	if maxErrors == 0 {
+		maxErrors = 3
+	}
 
-	delay := initialDelay
+	schedule := opts.pollSchedule()
+	attempt := 1
+	delay := schedule(attempt, 0)
+	var consecutiveErrors int
 	for {
 		select {
 		case <-ctx.Done():
@@ -514,7 +2740,19 @@ func (c *Client) WaitForJob(ctx context.Context, opts WaitOptions, result *JobRe
 			var statusResp GetJobStatusResponse
 			err := c.GetJobStatus(ctx, GetJobStatusRequest{JobID: opts.JobID}, &statusResp)
 			if err != nil {
-				return err
+				// Transient failures are polled again on the next tick
+				consecutiveErrors++
+				if !isTransientError(err) || consecutiveErrors > maxErrors {
+					return err
+				}
+				attempt++
+				delay = max(schedule(attempt, delay), retryAfter(err))
+				continue
+			}
+			consecutiveErrors = 0
+
+			if onStatus != nil && !onStatus(statusResp.JobStatus) {
+				return ctx.Err()
 			}
 
 			switch statusResp.Status {
@@ -533,17 +2771,139 @@ func (c *Client) WaitForJob(ctx context.Context, opts WaitOptions, result *JobRe
 				}
 				return fmt.Errorf("job %s: %s", statusResp.Status, statusResp.Error)
 			case "pending", "working", "processing":
-				if delay < maxDelay {
-					delay *= 2
-					if delay > maxDelay {
-						delay = maxDelay
-					}
-				}
-				r := rand.New(rand.NewSource(time.Now().UnixNano()))
-				delay += time.Duration(r.Intn(int(jitter/time.Millisecond))) * time.Millisecond
+				attempt++
+				delay = schedule(attempt, delay)
 			default:
 				return fmt.Errorf("unknown job status: %s", statusResp.Status)
 			}
 		}
 	}
 }
+
+// nextPollDelay doubles delay up to maxDelay and adds random jitter
+func nextPollDelay(delay, maxDelay, jitter time.Duration) time.Duration {
+	if delay < maxDelay {
+		delay *= 2
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+	if jitter <= 0 {
+		return delay
+	}
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	return delay + time.Duration(r.Int63n(int64(jitter)))
+}
+
+// GetJobStatusesResponse contains the status of several jobs keyed by job ID
+type GetJobStatusesResponse struct {
+	Jobs map[string]JobStatus `json:"jobs"`
+}
+
+// GetJobStatuses checks the status of several async jobs in a single request.
+// Unknown job IDs are absent from the returned map.
+func (c *Client) GetJobStatuses(ctx context.Context, ids []string) (map[string]JobStatus, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("at least one job ID is required")
+	}
+
+	params := url.Values{}
+	for _, id := range ids {
+		params.Add("ids[]", id)
+	}
+
+	var resp GetJobStatusesResponse
+	if err := c.do(ctx, "GET", "job_status?"+params.Encode(), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Jobs, nil
+}
+
+// WaitForJobs polls several jobs until all of them finish, using GetJobStatuses so each
+// poll costs a single request. When the API does not support batched polling it falls
+// back to polling each job individually. WaitOptions.JobID is ignored. The results of
+// every job are returned along with an error describing any jobs that failed.
+func (c *Client) WaitForJobs(ctx context.Context, ids []string, opts WaitOptions) (map[string]JobResult, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("at least one job ID is required")
+	}
+
+	results := make(map[string]JobResult, len(ids))
+	pending := append([]string{}, ids...)
+	var errs []error
+	batched := true
+
+	schedule := opts.pollSchedule()
+	attempt := 1
+	delay := schedule(attempt, 0)
+	for {
+		select {
+		case <-ctx.Done():
+			return results, ctx.Err()
+		case <-time.After(delay):
+		}
+
+		statuses, err := c.pollJobs(ctx, pending, &batched)
+		if err != nil {
+			return results, err
+		}
+
+		var stillPending []string
+		for _, id := range pending {
+			status, ok := statuses[id]
+			if !ok {
+				return results, fmt.Errorf("job %s not found", id)
+			}
+
+			switch status.Status {
+			case "completed":
+				if status.Result != nil {
+					results[id] = *status.Result
+				} else {
+					results[id] = JobResult{Success: true}
+				}
+			case "failed", "cancelled":
+				if status.Result != nil {
+					results[id] = *status.Result
+				} else {
+					results[id] = JobResult{Success: false, Error: status.Error}
+				}
+				errs = append(errs, fmt.Errorf("job %s %s: %s", id, status.Status, status.Error))
+			case "pending", "working", "processing":
+				stillPending = append(stillPending, id)
+			default:
+				return results, fmt.Errorf("unknown job status: %s", status.Status)
+			}
+		}
+
+		pending = stillPending
+		if len(pending) == 0 {
+			return results, errors.Join(errs...)
+		}
+		attempt++
+		delay = schedule(attempt, delay)
+	}
+}
+
+// pollJobs fetches the status of ids, switching batched off and polling jobs one at a
+// time if the batched endpoint is not available
+func (c *Client) pollJobs(ctx context.Context, ids []string, batched *bool) (map[string]JobStatus, error) {
+	if *batched {
+		statuses, err := c.GetJobStatuses(ctx, ids)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || (apiErr.StatusCode != http.StatusNotFound && apiErr.StatusCode != http.StatusMethodNotAllowed) {
+			return statuses, err
+		}
+		*batched = false
+	}
+
+	statuses := make(map[string]JobStatus, len(ids))
+	for _, id := range ids {
+		var resp GetJobStatusResponse
+		if err := c.GetJobStatus(ctx, GetJobStatusRequest{JobID: id}, &resp); err != nil {
+			return nil, err
+		}
+		statuses[id] = resp.JobStatus
+	}
+	return statuses, nil
+}
@@ -1,8 +1,17 @@
 package v1_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"strings"
+	"syscall"
 	"testing"
+	"testing/iotest"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -78,6 +87,78 @@ func TestNewClientCustom(t *testing.T) {
 	assert.NotNil(t, client)
 }
 
+type failingTransport struct{}
+
+func (failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("no network")
+}
+
+func TestNewClientRegion(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		region      string
+		baseURL     string
+		expectedURL string
+		expectedErr string
+	}{
+		{
+			name:        "Default",
+			expectedURL: "https://app.publer.com/api/v1/users/me",
+		},
+		{
+			name:        "US",
+			region:      v1.RegionUS,
+			expectedURL: "https://app.publer.com/api/v1/users/me",
+		},
+		{
+			name:        "EU",
+			region:      v1.RegionEU,
+			expectedURL: "https://eu.publer.com/api/v1/users/me",
+		},
+		{
+			name:        "BaseURLTakesPrecedence",
+			region:      v1.RegionEU,
+			baseURL:     "http://localhost:8080",
+			expectedURL: "http://localhost:8080/users/me",
+		},
+		{
+			name:        "UnknownRegion",
+			region:      "apac",
+			expectedErr: `unknown region "apac": must be one of us or eu`,
+		},
+		{
+			name:        "UnknownRegionWithBaseURL",
+			region:      "apac",
+			baseURL:     "http://localhost:8080",
+			expectedErr: `unknown region "apac": must be one of us or eu`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var requestedURL string
+			client, err := v1.NewClient(v1.Config{
+				APIKey:      "test-api-key",
+				WorkspaceID: "test-workspace-id",
+				Region:      test.region,
+				BaseURL:     test.baseURL,
+				Client:      &http.Client{Transport: failingTransport{}},
+				OnResponse: func(info v1.ResponseInfo) {
+					requestedURL = info.URL
+				},
+			})
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				assert.Nil(t, client)
+				return
+			}
+			require.NoError(t, err)
+
+			var resp v1.GetMeResponse
+			_ = client.GetMe(context.Background(), v1.GetMeRequest{}, &resp)
+			assert.Equal(t, test.expectedURL, requestedURL)
+		})
+	}
+}
+
 func TestClientAuthentication(t *testing.T) {
 	// Create mock server
 	server := v1.SpawnMockServer()
@@ -94,3 +175,936 @@ func TestClientAuthentication(t *testing.T) {
 	// The client is now properly configured with the mock server's credentials
 	// Authentication validation happens automatically within the mock server
 }
+
+func TestVerifyCredentials(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name       string
+		statusCode int
+		body       any
+		wantErr    error
+	}{
+		{
+			name:    "Valid",
+			wantErr: nil,
+		},
+		{
+			name:       "InvalidAPIKey",
+			statusCode: 401,
+			body:       v1.ErrorResponse{Error: "unauthorized", Message: "Missing or invalid API key"},
+			wantErr:    v1.ErrInvalidAPIKey,
+		},
+		{
+			name:       "InvalidWorkspace",
+			statusCode: 400,
+			body:       v1.ErrorResponse{Error: "bad_request", Message: "Missing or invalid workspace ID"},
+			wantErr:    v1.ErrInvalidWorkspace,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			if test.statusCode != 0 {
+				server.SetErrorResponse("GET", "/api/v1/accounts", 0, test.statusCode, test.body, nil)
+			}
+
+			err := client.VerifyCredentials(context.Background())
+			if test.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, test.wantErr)
+
+			var apiErr *v1.APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, test.statusCode, apiErr.StatusCode)
+		})
+	}
+}
+
+func TestVerifyCredentialsOtherError(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetErrorResponse("GET", "/api/v1/accounts", 0, 500, v1.ErrorResponse{Error: "Internal Server Error"}, nil)
+
+	err := client.VerifyCredentials(context.Background())
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, v1.ErrInvalidAPIKey))
+	assert.False(t, errors.Is(err, v1.ErrInvalidWorkspace))
+}
+
+func TestWithOperation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	var infos []v1.ResponseInfo
+	client := server.ClientWithConfig(v1.Config{
+		OnResponse: func(info v1.ResponseInfo) {
+			infos = append(infos, info)
+		},
+	})
+
+	server.Reset()
+	server.SetCurrentUser(v1.User{ID: "user-1"})
+
+	ctx := v1.WithOperation(context.Background(), "bulk_schedule")
+	var scheduleResp v1.BulkScheduleResponse
+	err := client.BulkSchedule(ctx, v1.BulkScheduleRequest{
+		Posts: []v1.BulkPost{{Text: "Bulk post", Accounts: []string{"account-1"}}},
+	}, &scheduleResp)
+	require.NoError(t, err)
+
+	var meResp v1.GetMeResponse
+	err = client.GetMe(context.Background(), v1.GetMeRequest{}, &meResp)
+	require.NoError(t, err)
+
+	var postResp v1.GetPostResponse
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "missing"}, &postResp)
+	require.Error(t, err)
+
+	require.Len(t, infos, 3)
+	assert.Equal(t, "bulk_schedule", infos[0].Operation)
+	assert.Equal(t, "POST", infos[0].Method)
+	assert.Equal(t, 200, infos[0].StatusCode)
+	assert.NoError(t, infos[0].Err)
+
+	assert.Equal(t, "GET users/me", infos[1].Operation)
+	assert.Equal(t, 200, infos[1].StatusCode)
+
+	assert.Equal(t, "GET posts/missing", infos[2].Operation)
+	assert.Equal(t, 404, infos[2].StatusCode)
+	assert.Error(t, infos[2].Err)
+}
+
+func TestOnAttempt(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	var requests []v1.RequestInfo
+	var responses []v1.ResponseInfo
+	client := server.ClientWithConfig(v1.Config{
+		Retry: v1.RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond},
+		OnAttempt: func(req v1.RequestInfo, resp v1.ResponseInfo) {
+			requests = append(requests, req)
+			responses = append(responses, resp)
+		},
+	})
+
+	server.Reset()
+	server.SetTransientError("GET", "/api/v1/accounts", 2, 503)
+
+	ctx := v1.WithHeader(context.Background(), "X-Trace", "trace-1")
+	_, err := client.ListAccountsPage(ctx, 1)
+	require.NoError(t, err)
+
+	require.Len(t, requests, 3)
+	for i, req := range requests {
+		assert.Equal(t, i+1, req.Attempt)
+		assert.Equal(t, "GET", req.Method)
+		assert.Contains(t, req.URL, "/api/v1/accounts")
+		assert.Equal(t, "[redacted]", req.Header.Get("Authorization"))
+		assert.Equal(t, "trace-1", req.Header.Get("X-Trace"))
+		assert.Equal(t, "GET accounts", responses[i].Operation)
+		assert.Positive(t, responses[i].Duration)
+	}
+	assert.Equal(t, []int{503, 503, 200}, []int{responses[0].StatusCode, responses[1].StatusCode, responses[2].StatusCode})
+	assert.Error(t, responses[0].Err)
+	assert.NoError(t, responses[2].Err)
+}
+
+func TestTimeout(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	t.Run("SlowResponse", func(t *testing.T) {
+		server.Reset()
+		server.SetDelay(200 * time.Millisecond)
+
+		client := server.ClientWithConfig(v1.Config{Timeout: 20 * time.Millisecond})
+
+		start := time.Now()
+		_, err := client.ListAccountsPage(context.Background(), 1)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorContains(t, err, "request timed out after 20ms")
+		assert.Less(t, time.Since(start), 200*time.Millisecond)
+	})
+
+	t.Run("FastResponse", func(t *testing.T) {
+		server.Reset()
+
+		client := server.ClientWithConfig(v1.Config{Timeout: time.Second})
+
+		_, err := client.ListAccountsPage(context.Background(), 1)
+		require.NoError(t, err)
+	})
+
+	t.Run("EachAttemptGetsFreshTimeout", func(t *testing.T) {
+		server.Reset()
+		server.SetDelay(200 * time.Millisecond)
+
+		var attempts []int
+		client := server.ClientWithConfig(v1.Config{
+			Timeout: 20 * time.Millisecond,
+			Retry:   v1.RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond},
+			OnAttempt: func(req v1.RequestInfo, resp v1.ResponseInfo) {
+				attempts = append(attempts, req.Attempt)
+			},
+		})
+
+		_, err := client.ListAccountsPage(context.Background(), 1)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, []int{1, 2, 3}, attempts)
+		assert.Equal(t, 2, client.RetryCount())
+	})
+
+	t.Run("CallerDeadlineSooner", func(t *testing.T) {
+		server.Reset()
+		server.SetDelay(200 * time.Millisecond)
+
+		client := server.ClientWithConfig(v1.Config{
+			Timeout: time.Second,
+			Retry:   v1.RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond},
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.ListAccountsPage(ctx, 1)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.NotContains(t, err.Error(), "request timed out")
+		assert.Less(t, time.Since(start), 200*time.Millisecond)
+		assert.Equal(t, 0, client.RetryCount())
+	})
+}
+
+func TestOnRetry(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	type retryCall struct {
+		attempt   int
+		nextDelay time.Duration
+	}
+
+	var calls []retryCall
+	client := server.ClientWithConfig(v1.Config{
+		Retry: v1.RetryConfig{
+			MaxAttempts: 4,
+			BaseDelay:   time.Millisecond,
+			MaxDelay:    3 * time.Millisecond,
+		},
+		OnRetry: func(attempt int, err error, nextDelay time.Duration) {
+			var apiErr *v1.APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, 503, apiErr.StatusCode)
+			calls = append(calls, retryCall{attempt: attempt, nextDelay: nextDelay})
+		},
+	})
+
+	server.Reset()
+	server.SetTransientError("GET", "/api/v1/accounts", 3, 503)
+
+	_, err := client.ListAccountsPage(context.Background(), 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, []retryCall{
+		{attempt: 1, nextDelay: time.Millisecond},
+		{attempt: 2, nextDelay: 2 * time.Millisecond},
+		{attempt: 3, nextDelay: 3 * time.Millisecond},
+	}, calls)
+	assert.Equal(t, 3, client.RetryCount())
+	assert.Equal(t, 4, server.CallCount("GET", "/api/v1/accounts"))
+}
+
+func TestRetryExhausted(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	var retries int
+	client := server.ClientWithConfig(v1.Config{
+		Retry: v1.RetryConfig{
+			MaxAttempts: 2,
+			BaseDelay:   time.Millisecond,
+		},
+		OnRetry: func(attempt int, err error, nextDelay time.Duration) {
+			retries++
+		},
+	})
+
+	server.Reset()
+	server.SetTransientError("GET", "/api/v1/accounts", 5, 500)
+
+	_, err := client.ListAccountsPage(context.Background(), 1)
+	require.Error(t, err)
+
+	var apiErr *v1.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 500, apiErr.StatusCode)
+	assert.Equal(t, 1, retries)
+	assert.Equal(t, 1, client.RetryCount())
+}
+
+func TestRetryNotApplied(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	var retries int
+	client := server.ClientWithConfig(v1.Config{
+		Retry: v1.RetryConfig{MaxAttempts: 3},
+		OnRetry: func(attempt int, err error, nextDelay time.Duration) {
+			retries++
+		},
+	})
+
+	server.Reset()
+
+	// Client errors are never retried
+	server.SetTransientError("GET", "/api/v1/accounts", 1, 404)
+	_, err := client.ListAccountsPage(context.Background(), 1)
+	require.Error(t, err)
+
+	// Non-GET requests are not retried unless marked with WithRetrySafe
+	server.SetTransientError("POST", "/api/v1/posts/schedule/publish", 1, 503)
+	var publishResp v1.PublishResponse
+	err = client.Publish(context.Background(), v1.PublishRequest{
+		Text:     "Not retried",
+		Accounts: []string{"account-1"},
+	}, &publishResp)
+	require.Error(t, err)
+
+	assert.Equal(t, 0, retries)
+	assert.Equal(t, 0, client.RetryCount())
+}
+
+func TestRetrySafePost(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.ClientWithConfig(v1.Config{
+		Retry: v1.RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+
+	server.Reset()
+	server.SetTransientError("POST", "/api/v1/posts/schedule/publish", 2, 503)
+
+	var resp v1.PublishResponse
+	err := client.Publish(v1.WithRetrySafe(context.Background()), v1.PublishRequest{
+		Text:     "Retried",
+		Accounts: []string{"account-1"},
+	}, &resp)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.JobID)
+	assert.Equal(t, 2, client.RetryCount())
+	assert.Equal(t, 3, server.CallCount("POST", "/api/v1/posts/schedule/publish"))
+}
+
+// flakyTransport fails the first failures requests with err before passing requests
+// on to the default transport
+type flakyTransport struct {
+	failures int
+	err      error
+	calls    int
+}
+
+func (f *flakyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, f.err
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRetryConnectionReset(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	transport := &flakyTransport{
+		failures: 2,
+		err:      &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET},
+	}
+	client := server.ClientWithConfig(v1.Config{
+		Client: &http.Client{Transport: transport},
+		Retry:  v1.RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+
+	server.Reset()
+
+	_, err := client.ListAccountsPage(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, transport.calls)
+	assert.Equal(t, 2, client.RetryCount())
+}
+
+// partialBodyTransport answers with a body that fails after its first bytes
+type partialBodyTransport struct {
+	calls int
+}
+
+func (p *partialBodyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	p.calls++
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(io.MultiReader(strings.NewReader(`{"accounts": [`), iotest.ErrReader(syscall.ECONNRESET))),
+		Request:    req,
+	}, nil
+}
+
+func TestRetryNotAppliedAfterPartialBody(t *testing.T) {
+	transport := &partialBodyTransport{}
+	client, err := v1.NewClient(v1.Config{
+		APIKey:      "test-key",
+		WorkspaceID: "test-workspace",
+		Client:      &http.Client{Transport: transport},
+		Retry:       v1.RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	_, err = client.ListAccountsPage(context.Background(), 1)
+	require.ErrorContains(t, err, "failed to read response body")
+	assert.Equal(t, 1, transport.calls)
+	assert.Equal(t, 0, client.RetryCount())
+}
+
+// rateLimitedTransport answers the first request with a 429 carrying Retry-After and
+// passes later requests on to the default transport
+type rateLimitedTransport struct {
+	retryAfter string
+	calls      int
+}
+
+func (r *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.calls++
+	if r.calls > 1 {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+	return &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{r.retryAfter}},
+		Body:       io.NopCloser(strings.NewReader(`{"error":"rate_limit_exceeded"}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	// Cancel once the retry is planned so the test does not wait out the delay
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var delays []time.Duration
+	transport := &rateLimitedTransport{retryAfter: "3600"}
+	client := server.ClientWithConfig(v1.Config{
+		Client: &http.Client{Transport: transport},
+		Retry:  v1.RetryConfig{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond},
+		OnRetry: func(attempt int, err error, nextDelay time.Duration) {
+			delays = append(delays, nextDelay)
+			cancel()
+		},
+	})
+
+	server.Reset()
+
+	// Retry-After is honored even when it exceeds MaxDelay
+	_, err := client.ListAccountsPage(ctx, 1)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []time.Duration{time.Hour}, delays)
+	assert.Equal(t, 1, transport.calls)
+}
+
+func TestBaseContext(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	base, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := server.ClientWithConfig(v1.Config{BaseContext: base})
+
+	server.Reset()
+	server.AddPosts([]v1.Post{{ID: "post-01", Text: "Post 1", State: "published"}})
+	server.SetDelay(500 * time.Millisecond)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	// The per-call context is never cancelled, only the base context
+	iter := client.ListPosts(context.Background(), v1.ListPostsRequest{})
+
+	start := time.Now()
+	var page v1.Page[v1.Post]
+	assert.False(t, iter.Next(context.Background(), &page))
+	require.Error(t, iter.Err())
+	assert.ErrorIs(t, iter.Err(), context.Canceled)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	// Future requests fail immediately
+	server.SetDelay(0)
+	_, err := client.ListAccountsPage(context.Background(), 1)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestLastFromCache(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.ClientWithConfig(v1.Config{ETagCache: true})
+
+	server.Reset()
+	server.AddPosts([]v1.Post{{ID: "post-cached", Text: "Cached text", State: "published"}})
+
+	var first v1.GetPostResponse
+	err := client.GetPost(context.Background(), v1.GetPostRequest{PostID: "post-cached"}, &first)
+	require.NoError(t, err)
+	assert.False(t, client.LastFromCache())
+
+	var second v1.GetPostResponse
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "post-cached"}, &second)
+	require.NoError(t, err)
+	assert.True(t, client.LastFromCache())
+	assert.Equal(t, first, second)
+
+	// A changed post invalidates the ETag
+	server.UpdateMockPost("post-cached", map[string]any{"text": "Updated text"})
+
+	var third v1.GetPostResponse
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "post-cached"}, &third)
+	require.NoError(t, err)
+	assert.False(t, client.LastFromCache())
+	assert.Equal(t, "Updated text", third.Text)
+}
+
+func TestETagCachePerWorkspace(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.ClientWithConfig(v1.Config{ETagCache: true})
+
+	server.Reset()
+	server.AddWorkspaces([]v1.Workspace{{ID: "workspace-a"}, {ID: "workspace-b"}})
+	server.AddPosts([]v1.Post{{ID: "post-cached", Text: "Cached text", State: "published"}})
+
+	for _, test := range []struct {
+		workspaceID string
+		fromCache   bool
+	}{
+		{workspaceID: "workspace-a", fromCache: false},
+		{workspaceID: "workspace-b", fromCache: false},
+		{workspaceID: "workspace-a", fromCache: true},
+		{workspaceID: "workspace-b", fromCache: true},
+	} {
+		ctx := v1.WithHeader(context.Background(), "Publer-Workspace-Id", test.workspaceID)
+		var resp v1.GetPostResponse
+		require.NoError(t, client.GetPost(ctx, v1.GetPostRequest{PostID: "post-cached"}, &resp))
+		assert.Equal(t, test.fromCache, client.LastFromCache(), test.workspaceID)
+	}
+}
+
+func TestLastFromCacheDisabled(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{{ID: "post-cached", Text: "Cached text", State: "published"}})
+
+	for i := 0; i < 2; i++ {
+		var resp v1.GetPostResponse
+		err := client.GetPost(context.Background(), v1.GetPostRequest{PostID: "post-cached"}, &resp)
+		require.NoError(t, err)
+		assert.Equal(t, "Cached text", resp.Text)
+		assert.False(t, client.LastFromCache())
+	}
+}
+
+func TestRedactBodies(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	disabled := false
+	for _, test := range []struct {
+		name   string
+		redact *bool
+		want   func(t *testing.T, info v1.ResponseInfo)
+	}{
+		{
+			name:   "DefaultRedacts",
+			redact: nil,
+			want: func(t *testing.T, info v1.ResponseInfo) {
+				assert.Regexp(t, `^\[redacted \d+ bytes\]$`, info.RequestBody)
+				assert.Regexp(t, `^\[redacted \d+ bytes\]$`, info.ResponseBody)
+				assert.NotContains(t, info.RequestBody, "Secret draft")
+			},
+		},
+		{
+			name:   "Disabled",
+			redact: &disabled,
+			want: func(t *testing.T, info v1.ResponseInfo) {
+				assert.Contains(t, info.RequestBody, "Secret draft")
+				assert.Contains(t, info.ResponseBody, "job_id")
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var infos []v1.ResponseInfo
+			client := server.ClientWithConfig(v1.Config{
+				RedactBodies: test.redact,
+				OnResponse: func(info v1.ResponseInfo) {
+					infos = append(infos, info)
+				},
+			})
+
+			server.Reset()
+
+			var resp v1.PublishResponse
+			err := client.Publish(context.Background(), v1.PublishRequest{
+				Text:     "Secret draft",
+				Accounts: []string{"account-1"},
+			}, &resp)
+			require.NoError(t, err)
+
+			require.Len(t, infos, 1)
+			assert.Equal(t, "POST", infos[0].Method)
+			assert.Contains(t, infos[0].URL, "/posts/schedule/publish")
+			assert.Equal(t, 200, infos[0].StatusCode)
+			test.want(t, infos[0])
+		})
+	}
+}
+
+func TestDiagnose(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetCurrentUser(v1.User{ID: "user-1"})
+	server.SetRateLimit(100, 42)
+
+	diag, err := client.Diagnose(context.Background())
+	require.NoError(t, err)
+	assert.True(t, diag.Reachable)
+	assert.Greater(t, diag.Latency, time.Duration(0))
+	assert.Equal(t, 42, diag.RateRemaining)
+}
+
+func TestDiagnoseWithoutRateHeaders(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetCurrentUser(v1.User{ID: "user-1"})
+
+	diag, err := client.Diagnose(context.Background())
+	require.NoError(t, err)
+	assert.True(t, diag.Reachable)
+	assert.Equal(t, -1, diag.RateRemaining)
+}
+
+func TestDiagnoseUnreachable(t *testing.T) {
+	client, err := v1.NewClient(v1.Config{
+		APIKey:      "test-api-key",
+		WorkspaceID: "test-workspace-id",
+		Client:      &http.Client{Transport: failingTransport{}},
+	})
+	require.NoError(t, err)
+
+	diag, err := client.Diagnose(context.Background())
+	require.ErrorContains(t, err, "no network")
+	assert.False(t, diag.Reachable)
+	assert.Equal(t, -1, diag.RateRemaining)
+}
+
+// headerRecorder is a RoundTripper that records the headers of every request
+type headerRecorder struct {
+	headers []http.Header
+}
+
+func (h *headerRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	h.headers = append(h.headers, req.Header.Clone())
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestGenerateCorrelationID(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	recorder := &headerRecorder{}
+	client := server.ClientWithConfig(v1.Config{
+		Client:                &http.Client{Transport: recorder},
+		GenerateCorrelationID: true,
+	})
+
+	server.Reset()
+	server.SetCurrentUser(v1.User{ID: "user-1"})
+
+	var resp v1.GetMeResponse
+	require.NoError(t, client.GetMe(context.Background(), v1.GetMeRequest{}, &resp))
+	require.NoError(t, client.GetMe(context.Background(), v1.GetMeRequest{}, &resp))
+
+	require.Len(t, recorder.headers, 2)
+	first := recorder.headers[0].Get("X-Correlation-Id")
+	second := recorder.headers[1].Get("X-Correlation-Id")
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, first)
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, second, client.LastCorrelationID())
+}
+
+func TestGenerateCorrelationIDKeepsExisting(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	recorder := &headerRecorder{}
+	client := server.ClientWithConfig(v1.Config{
+		Client:                &http.Client{Transport: recorder},
+		GenerateCorrelationID: true,
+	})
+
+	server.Reset()
+
+	// The API error carries the caller supplied ID
+	ctx := v1.WithHeader(context.Background(), "X-Correlation-Id", "trace-123")
+	var resp v1.GetMeResponse
+	err := client.GetMe(ctx, v1.GetMeRequest{}, &resp)
+	require.Error(t, err)
+
+	var apiErr *v1.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "trace-123", apiErr.CorrelationID)
+	assert.Equal(t, "trace-123", client.LastCorrelationID())
+	require.Len(t, recorder.headers, 1)
+	assert.Equal(t, "trace-123", recorder.headers[0].Get("X-Correlation-Id"))
+}
+
+func TestGenerateCorrelationIDDisabled(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	recorder := &headerRecorder{}
+	client := server.ClientWithConfig(v1.Config{
+		Client: &http.Client{Transport: recorder},
+	})
+
+	server.Reset()
+	server.SetCurrentUser(v1.User{ID: "user-1"})
+
+	var resp v1.GetMeResponse
+	require.NoError(t, client.GetMe(context.Background(), v1.GetMeRequest{}, &resp))
+	require.Len(t, recorder.headers, 1)
+	assert.Empty(t, recorder.headers[0].Get("X-Correlation-Id"))
+	assert.Empty(t, client.LastCorrelationID())
+}
+
+func TestDryRun(t *testing.T) {
+	// Any request reaching the transport fails, proving nothing is sent
+	client, err := v1.NewClient(v1.Config{
+		APIKey:      "test-key",
+		WorkspaceID: "test-workspace",
+		BaseURL:     "https://app.publer.com/api/v1/",
+		Client:      &http.Client{Transport: failingTransport{}},
+		DryRun:      true,
+	})
+	require.NoError(t, err)
+
+	var publishResp v1.PublishResponse
+	err = client.Publish(context.Background(), v1.PublishRequest{
+		Text:     "Dry run post",
+		Accounts: []string{"acc-001"},
+	}, &publishResp)
+	require.NoError(t, err)
+	assert.Empty(t, publishResp.JobID)
+
+	var meResp v1.GetMeResponse
+	require.NoError(t, client.GetMe(context.Background(), v1.GetMeRequest{}, &meResp))
+	assert.Empty(t, meResp.ID)
+
+	requests := client.DryRunRequests()
+	require.Len(t, requests, 2)
+
+	assert.Equal(t, http.MethodPost, requests[0].Method)
+	assert.Equal(t, "https://app.publer.com/api/v1/posts/schedule/publish", requests[0].URL)
+	assert.Equal(t, "Bearer-API test-key", requests[0].Header.Get("Authorization"))
+	assert.Equal(t, "test-workspace", requests[0].Header.Get("Publer-Workspace-Id"))
+	assert.Equal(t, "application/json", requests[0].Header.Get("Content-Type"))
+	assert.Contains(t, string(requests[0].Body), `"text":"Dry run post"`)
+
+	assert.Equal(t, http.MethodGet, requests[1].Method)
+	assert.Equal(t, "https://app.publer.com/api/v1/users/me", requests[1].URL)
+	assert.Empty(t, requests[1].Body)
+}
+
+func TestBatch(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetCurrentUser(v1.User{ID: "user-1"})
+	var posts []v1.Post
+	for i := 1; i <= 20; i++ {
+		posts = append(posts, v1.Post{ID: fmt.Sprintf("post-%02d", i), Text: "Original", State: "scheduled"})
+	}
+	server.AddPosts(posts)
+
+	var ops []func(context.Context) error
+	for i := 1; i <= 20; i++ {
+		postID := fmt.Sprintf("post-%02d", i)
+		ops = append(ops, func(ctx context.Context) error {
+			var resp v1.UpdatePostResponse
+			return client.UpdatePost(ctx, v1.UpdatePostRequest{PostID: postID, Text: "Updated"}, &resp)
+		})
+	}
+	ops = append(ops,
+		func(ctx context.Context) error {
+			var resp v1.GetMeResponse
+			return client.GetMe(ctx, v1.GetMeRequest{}, &resp)
+		},
+		func(ctx context.Context) error {
+			var resp v1.GetPostResponse
+			return client.GetPost(ctx, v1.GetPostRequest{PostID: "post-missing"}, &resp)
+		},
+		func(ctx context.Context) error {
+			_, err := client.ListAccountsPage(ctx, 1)
+			return err
+		},
+	)
+
+	errs := client.Batch(context.Background(), ops, 5)
+	require.Len(t, errs, 23)
+	for i := 0; i < 21; i++ {
+		assert.NoError(t, errs[i])
+	}
+	assert.ErrorContains(t, errs[21], "Post not found")
+	assert.NoError(t, errs[22])
+
+	for i := 1; i <= 20; i++ {
+		var resp v1.GetPostResponse
+		require.NoError(t, client.GetPost(context.Background(), v1.GetPostRequest{PostID: fmt.Sprintf("post-%02d", i)}, &resp))
+		assert.Equal(t, "Updated", resp.Text)
+	}
+}
+
+func TestBatchRateLimitPause(t *testing.T) {
+	client, err := v1.NewClient(v1.Config{APIKey: "test-api-key", WorkspaceID: "test-workspace-id"})
+	require.NoError(t, err)
+
+	reset := time.Now().Add(time.Second).Truncate(time.Second)
+	var started time.Time
+	errs := client.Batch(context.Background(), []func(context.Context) error{
+		func(ctx context.Context) error {
+			return &v1.RateLimitError{APIError: v1.APIError{StatusCode: 429}, Reset: reset.Unix()}
+		},
+		func(ctx context.Context) error {
+			started = time.Now()
+			return nil
+		},
+	}, 1)
+
+	var rateLimitErr *v1.RateLimitError
+	assert.ErrorAs(t, errs[0], &rateLimitErr)
+	assert.NoError(t, errs[1])
+	assert.False(t, started.Before(reset))
+}
+
+func TestBatchContextCancelled(t *testing.T) {
+	client, err := v1.NewClient(v1.Config{APIKey: "test-api-key", WorkspaceID: "test-workspace-id"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := client.Batch(ctx, []func(context.Context) error{
+		func(ctx context.Context) error {
+			cancel()
+			return nil
+		},
+		func(ctx context.Context) error {
+			return errors.New("should not run")
+		},
+	}, 1)
+
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], context.Canceled)
+}
+
+func TestSuggestChunkSize(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name          string
+		bulkLimit     int
+		rateRemaining int
+		totalPosts    int
+		expected      int
+		expectedErr   string
+	}{
+		{
+			name:          "EvenChunks",
+			bulkLimit:     100,
+			rateRemaining: 50,
+			totalPosts:    250,
+			expected:      84,
+		},
+		{
+			name:          "UnderLimit",
+			bulkLimit:     100,
+			rateRemaining: 50,
+			totalPosts:    30,
+			expected:      30,
+		},
+		{
+			name:          "NoBulkLimit",
+			rateRemaining: 50,
+			totalPosts:    500,
+			expected:      500,
+		},
+		{
+			name:          "RateLimitTooLow",
+			bulkLimit:     10,
+			rateRemaining: 3,
+			totalPosts:    45,
+			expected:      9,
+			expectedErr:   "5 chunks are needed but only 3 requests remain in the rate limit window",
+		},
+		{
+			name:        "NoPosts",
+			totalPosts:  0,
+			expectedErr: "total posts must be positive, got 0",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.SetBulkOperationLimit(test.bulkLimit)
+			server.SetRateLimit(100, test.rateRemaining)
+
+			size, err := client.SuggestChunkSize(context.Background(), test.totalPosts)
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, size)
+		})
+	}
+}
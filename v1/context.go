@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+)
+
+// contextKey is the type for values this package stores in a context
+type contextKey int
+
+const (
+	operationKey contextKey = iota
+	headersKey
+	defaultAccountsKey
+	retrySafeKey
+	responseMetaKey
+	waitOptionsKey
+)
+
+// WithOperation returns a context that labels requests made with it using the given
+// operation name (e.g. "bulk_schedule"). The label is passed to Config.OnResponse.
+func WithOperation(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationKey, name)
+}
+
+// operationFromContext returns the operation label stored in ctx, or fallback when unset
+func operationFromContext(ctx context.Context, fallback string) string {
+	if name, ok := ctx.Value(operationKey).(string); ok && name != "" {
+		return name
+	}
+	return fallback
+}
+
+// WithHeader returns a context that adds the given HTTP header to requests made with it.
+// Headers set this way take precedence over the client's defaults, such as the
+// Publer-Workspace-Id header.
+func WithHeader(ctx context.Context, name, value string) context.Context {
+	headers := http.Header{}
+	if existing, ok := ctx.Value(headersKey).(http.Header); ok {
+		headers = existing.Clone()
+	}
+	headers.Set(name, value)
+	return context.WithValue(ctx, headersKey, headers)
+}
+
+// headersFromContext returns the headers added with WithHeader, if any
+func headersFromContext(ctx context.Context) http.Header {
+	headers, _ := ctx.Value(headersKey).(http.Header)
+	return headers
+}
+
+// WithDefaultAccounts returns a context whose accounts are used by Publish, Schedule
+// and CreateDraft when a request has no accounts, such as the accounts of a user
+// session. Accounts are resolved in order of precedence: those on the request, then
+// those set with WithDefaultAccounts, then Config.DefaultAccounts.
+func WithDefaultAccounts(ctx context.Context, accountIDs ...string) context.Context {
+	return context.WithValue(ctx, defaultAccountsKey, append([]string(nil), accountIDs...))
+}
+
+// defaultAccountsFromContext returns the accounts set with WithDefaultAccounts, if any
+func defaultAccountsFromContext(ctx context.Context) []string {
+	accounts, _ := ctx.Value(defaultAccountsKey).([]string)
+	return accounts
+}
+
+// WithRetrySafe returns a context that marks requests made with it as safe to retry
+// under Config.Retry even when they are not GETs, such as a POST the caller knows
+// is idempotent
+func WithRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey, true)
+}
+
+// retrySafeFromContext reports whether ctx was marked with WithRetrySafe
+func retrySafeFromContext(ctx context.Context) bool {
+	safe, _ := ctx.Value(retrySafeKey).(bool)
+	return safe
+}
+
+// ResponseMeta holds the raw status and headers of an HTTP response
+type ResponseMeta struct {
+	StatusCode int
+	Header     http.Header
+}
+
+// WithResponseMeta returns a context that records the status and headers of the
+// responses to requests made with it in meta, such as X-RateLimit-Remaining. When a
+// call makes several requests, through retries or paging, meta holds the last
+// response received. meta is left unchanged when no response is received and must
+// not be shared by requests made concurrently.
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey, meta)
+}
+
+// responseMetaFromContext returns the ResponseMeta set with WithResponseMeta, if any
+func responseMetaFromContext(ctx context.Context) *ResponseMeta {
+	meta, _ := ctx.Value(responseMetaKey).(*ResponseMeta)
+	return meta
+}
+
+// WithWaitOptions returns a context carrying the job polling options used by calls
+// that wait on a job without taking WaitOptions, such as BulkScheduleWithProgress.
+// The JobID of opts is ignored.
+func WithWaitOptions(ctx context.Context, opts WaitOptions) context.Context {
+	return context.WithValue(ctx, waitOptionsKey, opts)
+}
+
+// waitOptionsFromContext returns the WaitOptions set with WithWaitOptions, or the zero
+// value for the default polling schedule
+func waitOptionsFromContext(ctx context.Context) WaitOptions {
+	opts, _ := ctx.Value(waitOptionsKey).(WaitOptions)
+	return opts
+}
+
+// mergeContext returns a context that is cancelled when either ctx or base is done.
+// The returned cancel func must be called to release resources.
+func mergeContext(ctx, base context.Context) (context.Context, context.CancelFunc) {
+	merged, cancel := context.WithCancel(ctx)
+	if base.Err() != nil {
+		cancel()
+		return merged, cancel
+	}
+
+	stop := context.AfterFunc(base, cancel)
+	return merged, func() {
+		stop()
+		cancel()
+	}
+}
@@ -2,6 +2,8 @@ package v1
 
 import (
 	"fmt"
+	"net/http"
+	"time"
 )
 
 // ErrorResponse represents the JSON error response from Publer API
@@ -17,6 +19,11 @@ type APIError struct {
 	URL        string
 	StatusCode int
 	Message    string
+	Code       string // stable machine readable code such as "post_not_found", if provided
+	Body       string // raw response body
+
+	// CorrelationID is the X-Correlation-Id header sent with the request, if any
+	CorrelationID string
 }
 
 // Error returns the formatted error message
@@ -24,12 +31,30 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("%s %s with %d returned \"%s\"", e.Method, e.URL, e.StatusCode, e.Message)
 }
 
+// Is reports whether a 404 response matches ErrNotFound, so callers can use
+// errors.Is(err, ErrNotFound) instead of matching the message
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
 // RateLimitError represents a rate limit exceeded error
 type RateLimitError struct {
 	APIError
 	Limit     int
 	Remaining int
 	Reset     int64
+
+	// RetryAfter is how long the Retry-After header asked to wait, 0 when absent
+	RetryAfter time.Duration
+
+	// retryAt is when the request may be retried according to Retry-After
+	retryAt time.Time
+}
+
+// RetryAfterTime returns when the request may be retried according to the Retry-After
+// header, or the zero time when the response had none
+func (e *RateLimitError) RetryAfterTime() time.Time {
+	return e.retryAt
 }
 
 // Error returns the formatted rate limit error message
@@ -48,5 +73,33 @@ func (e *RateLimitError) As(target interface{}) bool {
 	}
 }
 
+// ValidationError is returned when a request fails client-side validation before
+// it is sent. Field holds the JSON name of the offending field.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+// Error returns the formatted validation error message
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("validation failed for %s: %s", e.Field, e.Message)
+}
+
 // ErrNoMoreItems is returned when there are no more items in an iterator
-var ErrNoMoreItems = fmt.Errorf("no more items")
\ No newline at end of file
+var ErrNoMoreItems = fmt.Errorf("no more items")
+
+// ErrStopIteration is returned by a ForEach callback to stop iterating without error
+var ErrStopIteration = fmt.Errorf("stop iteration")
+
+// ErrInvalidAPIKey is returned when the API rejects the configured API key
+var ErrInvalidAPIKey = fmt.Errorf("invalid API key")
+
+// ErrInvalidWorkspace is returned when the API rejects the configured workspace ID
+var ErrInvalidWorkspace = fmt.Errorf("invalid workspace ID")
+
+// ErrNotFound matches API errors for resources that do not exist (HTTP 404)
+var ErrNotFound = fmt.Errorf("not found")
+
+// ErrFeatureNotAvailable is returned when Config.CheckFeatures is enabled and the
+// workspace plan does not include a feature required by the operation
+var ErrFeatureNotAvailable = fmt.Errorf("feature not available on your plan")
@@ -1,8 +1,11 @@
 package v1_test
 
 import (
+	"context"
 	"errors"
+	"net/http"
 	"testing"
+	"time"
 
 	v1 "github.com/thrawn/publer.go/v1"
 	"github.com/stretchr/testify/assert"
@@ -107,4 +110,143 @@ func TestErrorFormatting(t *testing.T) {
 			assert.Equal(t, test.expected, test.err.Error())
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestAPIErrorNonJSONBody(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name        string
+		contentType string
+		body        string
+		wantMessage string
+	}{
+		{
+			name:        "HTML",
+			contentType: "text/html; charset=utf-8",
+			body:        "<html>\n<head><title>502 Bad Gateway</title></head>\n<body>Bad Gateway</body>\n</html>\n",
+			wantMessage: "upstream returned text/html 502",
+		},
+		{
+			name:        "PlainText",
+			contentType: "text/plain",
+			body:        "Bad Gateway",
+			wantMessage: "Bad Gateway",
+		},
+		{
+			name:        "JSON",
+			contentType: "application/json",
+			body:        `{"error":"bad_gateway","message":"Upstream unavailable"}`,
+			wantMessage: "Upstream unavailable",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.SetErrorResponse("GET", "/api/v1/accounts", 0, 502, []byte(test.body),
+				map[string]string{"Content-Type": test.contentType})
+
+			_, err := client.ListAccountsPage(context.Background(), 1)
+			require.Error(t, err)
+
+			var apiErr *v1.APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, 502, apiErr.StatusCode)
+			assert.Equal(t, test.wantMessage, apiErr.Message)
+			assert.Equal(t, test.body, apiErr.Body)
+		})
+	}
+}
+
+func TestErrNotFound(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+	server.Reset()
+
+	var resp v1.GetPostResponse
+	err := client.GetPost(context.Background(), v1.GetPostRequest{PostID: "999999"}, &resp)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, v1.ErrNotFound)
+
+	var apiErr *v1.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "post_not_found", apiErr.Code)
+
+	// Errors other than 404 must not match
+	server.SetErrorResponse("GET", "/api/v1/accounts", 0, 502, []byte(`{"error":"bad_gateway","message":"Upstream unavailable"}`),
+		map[string]string{"Content-Type": "application/json"})
+	_, err = client.ListAccountsPage(context.Background(), 1)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, v1.ErrNotFound)
+}
+
+func TestRateLimitErrorRetryAfter(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	retryAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	for _, test := range []struct {
+		name            string
+		header          string
+		expectedAfter   time.Duration
+		expectedRetryAt func(sent time.Time) time.Time
+	}{
+		{
+			name:            "Seconds",
+			header:          "120",
+			expectedAfter:   120 * time.Second,
+			expectedRetryAt: func(sent time.Time) time.Time { return sent.Add(120 * time.Second) },
+		},
+		{
+			name:            "HTTPDate",
+			header:          retryAt.Format(http.TimeFormat),
+			expectedAfter:   time.Hour,
+			expectedRetryAt: func(time.Time) time.Time { return retryAt },
+		},
+		{
+			name:            "Missing",
+			expectedRetryAt: func(time.Time) time.Time { return time.Time{} },
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			headers := map[string]string{}
+			if test.header != "" {
+				headers["Retry-After"] = test.header
+			}
+			server.SetErrorResponse("GET", "/api/v1/accounts", 0, 429, map[string]string{"error": "rate_limit_exceeded"}, headers)
+
+			sent := time.Now()
+			_, err := client.ListAccountsPage(context.Background(), 1)
+			var rateLimitErr *v1.RateLimitError
+			require.ErrorAs(t, err, &rateLimitErr)
+
+			assert.InDelta(t, test.expectedAfter, rateLimitErr.RetryAfter, float64(2*time.Second))
+			assert.WithinDuration(t, test.expectedRetryAt(sent), rateLimitErr.RetryAfterTime(), 2*time.Second)
+		})
+	}
+}
+
+func TestRateLimitErrorRetryAfterUsesConfigNow(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	client := server.ClientWithConfig(v1.Config{Now: func() time.Time { return now }})
+
+	server.Reset()
+	headers := map[string]string{"Retry-After": now.Add(30 * time.Second).Format(http.TimeFormat)}
+	server.SetErrorResponse("GET", "/api/v1/accounts", 0, 429, map[string]string{"error": "rate_limit_exceeded"}, headers)
+
+	_, err := client.ListAccountsPage(context.Background(), 1)
+	var rateLimitErr *v1.RateLimitError
+	require.ErrorAs(t, err, &rateLimitErr)
+	assert.Equal(t, 30*time.Second, rateLimitErr.RetryAfter)
+	assert.True(t, now.Add(30*time.Second).Equal(rateLimitErr.RetryAfterTime()))
+}
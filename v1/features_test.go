@@ -0,0 +1,111 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/thrawn/publer.go/v1"
+)
+
+func TestFeatures(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetFeatures(map[string]bool{
+		v1.FeatureRecurringPosts: true,
+		v1.FeatureAutoSchedule:   false,
+	})
+
+	features, err := client.Features(context.Background())
+	require.NoError(t, err)
+	assert.True(t, features[v1.FeatureRecurringPosts])
+	assert.False(t, features[v1.FeatureAutoSchedule])
+	assert.False(t, features[v1.FeatureApprovals])
+
+	// Features are cached after the first call, and changing the result leaves the
+	// cache intact
+	features[v1.FeatureAutoSchedule] = true
+	features, err = client.Features(context.Background())
+	require.NoError(t, err)
+	assert.False(t, features[v1.FeatureAutoSchedule])
+	assert.Equal(t, 1, server.CallCount("GET", "/api/v1/features"))
+}
+
+func TestCheckFeatures(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	now := time.Now()
+	autoSchedule := v1.AutoScheduleRequest{
+		Text:      "Auto scheduled post",
+		Accounts:  []string{"account-1"},
+		StartDate: now,
+		EndDate:   now.Add(7 * 24 * time.Hour),
+		Slots:     3,
+	}
+	recurring := v1.RecurringPostRequest{
+		Text:       "Recurring post",
+		Accounts:   []string{"account-1"},
+		Recurrence: v1.RecurrenceRule{Frequency: "daily", Interval: 1, Count: 5},
+	}
+
+	for _, test := range []struct {
+		name          string
+		checkFeatures bool
+		features      map[string]bool
+		wantErr       bool
+	}{
+		{
+			name:          "Available",
+			checkFeatures: true,
+			features:      map[string]bool{v1.FeatureAutoSchedule: true, v1.FeatureRecurringPosts: true, v1.FeatureApprovals: true},
+		},
+		{
+			name:          "Unavailable",
+			checkFeatures: true,
+			features:      map[string]bool{v1.FeatureAutoSchedule: false},
+			wantErr:       true,
+		},
+		{
+			name:          "CheckDisabled",
+			checkFeatures: false,
+			features:      map[string]bool{},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.SetFeatures(test.features)
+
+			client := server.ClientWithConfig(v1.Config{CheckFeatures: test.checkFeatures})
+
+			var autoResp v1.AutoScheduleResponse
+			autoErr := client.AutoSchedulePost(context.Background(), autoSchedule, &autoResp)
+
+			var recurringResp v1.RecurringPostResponse
+			recurringErr := client.CreateRecurringPost(context.Background(), recurring, &recurringResp)
+
+			_, approvalsErr := v1.Collect(context.Background(), client.PendingApprovals(context.Background()))
+
+			if test.wantErr {
+				require.ErrorIs(t, autoErr, v1.ErrFeatureNotAvailable)
+				assert.ErrorContains(t, autoErr, "feature not available on your plan: auto_schedule")
+				require.ErrorIs(t, recurringErr, v1.ErrFeatureNotAvailable)
+				require.ErrorIs(t, approvalsErr, v1.ErrFeatureNotAvailable)
+				assert.Equal(t, 0, server.CallCount("POST", "/api/v1/posts/auto-schedule"))
+				assert.Equal(t, 0, server.CallCount("GET", "/api/v1/posts"))
+				return
+			}
+			require.NoError(t, autoErr)
+			require.NoError(t, recurringErr)
+			require.NoError(t, approvalsErr)
+			assert.NotEmpty(t, autoResp.JobID)
+			assert.NotEmpty(t, recurringResp.JobID)
+		})
+	}
+}
@@ -2,6 +2,11 @@ package v1
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
 )
 
 // Page represents a page of results from paginated API
@@ -11,6 +16,56 @@ type Page[T any] struct {
 	Page       int `json:"page"`
 	PerPage    int `json:"per_page"`
 	TotalPages int `json:"total_pages"`
+
+	// ItemErrors holds the decode errors of malformed items left out of Items when
+	// Config.TolerantDecoding is enabled
+	ItemErrors []error `json:"-"`
+}
+
+// fetchTolerantPage fetches a list page whose items are held under key, decoding
+// each item on its own so a malformed item is reported in ItemErrors instead of
+// failing the whole page
+func fetchTolerantPage[T any](ctx context.Context, c *Client, path, key string) (*Page[T], error) {
+	var body json.RawMessage
+	if err := c.do(ctx, "GET", path, nil, &body); err != nil {
+		return nil, err
+	}
+
+	var meta struct {
+		Total      int `json:"total"`
+		Page       int `json:"page"`
+		PerPage    int `json:"per_page"`
+		TotalPages int `json:"total_pages"`
+	}
+	if err := json.Unmarshal(body, &meta); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	page := Page[T]{
+		Total:      meta.Total,
+		Page:       meta.Page,
+		PerPage:    meta.PerPage,
+		TotalPages: meta.TotalPages,
+	}
+
+	var items []json.RawMessage
+	if raw, ok := fields[key]; ok {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", key, err)
+		}
+	}
+	for i, raw := range items {
+		var item T
+		if err := json.Unmarshal(raw, &item); err != nil {
+			page.ItemErrors = append(page.ItemErrors, fmt.Errorf("item %d: %w", i+1, err))
+			continue
+		}
+		page.Items = append(page.Items, item)
+	}
+	return &page, nil
 }
 
 // Iterator provides iteration over paginated API resources
@@ -31,6 +86,7 @@ type GenericIterator[T any] struct {
 	totalPages  int
 	err         error
 	initialized bool
+	maxPages    int
 }
 
 // NewGenericIterator creates a new iterator for paginated resources
@@ -40,6 +96,50 @@ func NewGenericIterator[T any](fetcher PageFetcher[T]) *GenericIterator[T] {
 	}
 }
 
+// SetMaxPages stops iteration after n pages even if the API reports more, leaving Err()
+// nil. A value of zero or less removes the bound. When wrapped by LimitIterator,
+// iteration stops at whichever of the page or item bound is reached first.
+func (it *GenericIterator[T]) SetMaxPages(n int) {
+	it.maxPages = n
+}
+
+// iteratorState is the progress encoded in a token returned by GenericIterator.State
+type iteratorState struct {
+	Page       int `json:"page"`
+	TotalPages int `json:"total_pages"`
+}
+
+// State returns an opaque token recording the pages fetched so far. Persist it to
+// resume iteration with RestoreIterator, for example after a worker restarts.
+func (it *GenericIterator[T]) State() string {
+	data, _ := json.Marshal(iteratorState{Page: it.currentPage, TotalPages: it.totalPages})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// RestoreIterator returns an iterator over fetcher that resumes after the last page
+// fetched by the iterator token was taken from with State. The fetcher must list the
+// same resource with the same filters as the original iterator.
+func RestoreIterator[T any](fetcher PageFetcher[T], token string) (*GenericIterator[T], error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid iterator state: %w", err)
+	}
+	var state iteratorState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("invalid iterator state: %w", err)
+	}
+	if state.Page < 0 || state.TotalPages < 0 {
+		return nil, fmt.Errorf("invalid iterator state: page %d of %d", state.Page, state.TotalPages)
+	}
+
+	return &GenericIterator[T]{
+		fetcher:     fetcher,
+		currentPage: state.Page,
+		totalPages:  state.TotalPages,
+		initialized: true,
+	}, nil
+}
+
 // Next fetches the next page of results
 // Returns false when no more pages or context cancelled
 // Check Err() for context cancellation or other errors
@@ -62,14 +162,18 @@ func (it *GenericIterator[T]) Next(ctx context.Context, page *Page[T]) bool {
 	if it.totalPages > 0 && it.currentPage >= it.totalPages {
 		return false
 	}
+	if it.maxPages > 0 && it.currentPage >= it.maxPages {
+		return false
+	}
 
-	// Fetch the next page
-	it.currentPage++
-	fetchedPage, err := it.fetcher.FetchPage(ctx, it.currentPage)
+	// Fetch the next page, only counting it once it is fetched so State never
+	// records a failed page as done
+	fetchedPage, err := it.fetcher.FetchPage(ctx, it.currentPage+1)
 	if err != nil {
-		it.err = err
+		it.err = contextError(ctx, err)
 		return false
 	}
+	it.currentPage++
 
 	// Update total pages if this is the first page
 	if it.currentPage == 1 {
@@ -80,10 +184,256 @@ func (it *GenericIterator[T]) Next(ctx context.Context, page *Page[T]) bool {
 	*page = *fetchedPage
 
 	// Check if we have more pages
+	if it.maxPages > 0 && it.currentPage >= it.maxPages {
+		return false
+	}
 	return it.currentPage < it.totalPages
 }
 
+// contextError returns err such that errors.Is matches ctx.Err() when ctx is done,
+// even if the fetcher formatted the context error into a message instead of wrapping it
+func contextError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil && !errors.Is(err, ctxErr) {
+		return fmt.Errorf("%w: %w", ctxErr, err)
+	}
+	return err
+}
+
 // Err returns any error encountered during iteration
 func (it *GenericIterator[T]) Err() error {
 	return it.err
-}
\ No newline at end of file
+}
+
+// StatusCode returns the HTTP status of the request that failed the iteration,
+// or 0 when there is no error or the error did not come from an HTTP response
+func (it *GenericIterator[T]) StatusCode() int {
+	var apiErr *APIError
+	if errors.As(it.err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// limitIterator wraps an Iterator and stops once a maximum number of items is yielded
+type limitIterator[T any] struct {
+	it      Iterator[T]
+	limit   int
+	yielded int
+}
+
+// LimitIterator returns an Iterator that yields at most limit items across all pages.
+// The page that crosses the limit is trimmed and no further pages are fetched.
+func LimitIterator[T any](it Iterator[T], limit int) Iterator[T] {
+	return &limitIterator[T]{
+		it:    it,
+		limit: limit,
+	}
+}
+
+// Next fetches the next page, trimming it to the remaining item budget
+func (l *limitIterator[T]) Next(ctx context.Context, page *Page[T]) bool {
+	if l.yielded >= l.limit {
+		return false
+	}
+
+	var fetched Page[T]
+	hasMore := l.it.Next(ctx, &fetched)
+	if l.it.Err() != nil {
+		return false
+	}
+
+	if remaining := l.limit - l.yielded; len(fetched.Items) > remaining {
+		fetched.Items = fetched.Items[:remaining]
+	}
+	l.yielded += len(fetched.Items)
+	*page = fetched
+
+	return hasMore && l.yielded < l.limit
+}
+
+// Err returns any error encountered by the wrapped iterator
+func (l *limitIterator[T]) Err() error {
+	return l.it.Err()
+}
+
+// CollectAllN drains it like Collect but fetches up to concurrency pages at
+// once, returning every item in page order. The first page is fetched alone to learn
+// the page count. Only iterators created by NewGenericIterator that have not started
+// are fetched concurrently; anything else, or a concurrency of 1 or less, is drained
+// serially. Pages are requested through the client so retry and rate limit handling
+// still apply, with concurrency bounding the number of requests in flight. The first
+// error cancels outstanding fetches and is returned with no items.
+func CollectAllN[T any](ctx context.Context, it Iterator[T], concurrency int) ([]T, error) {
+	generic, ok := it.(*GenericIterator[T])
+	if !ok || concurrency <= 1 || generic.initialized {
+		return Collect(ctx, it)
+	}
+
+	var first Page[T]
+	more := generic.Next(ctx, &first)
+	if err := generic.Err(); err != nil {
+		return nil, err
+	}
+	if !more {
+		return first.Items, nil
+	}
+
+	lastPage := generic.totalPages
+	if generic.maxPages > 0 && lastPage > generic.maxPages {
+		lastPage = generic.maxPages
+	}
+
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make([][]T, lastPage+1)
+	pages[1] = first.Items
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+	for pageNum := 2; pageNum <= lastPage; pageNum++ {
+		select {
+		case sem <- struct{}{}:
+		case <-fetchCtx.Done():
+		}
+		if fetchCtx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			page, err := generic.fetcher.FetchPage(fetchCtx, pageNum)
+			if err != nil {
+				once.Do(func() {
+					firstErr = contextError(ctx, err)
+					cancel()
+				})
+				return
+			}
+			pages[pageNum] = page.Items
+		}()
+	}
+	wg.Wait()
+
+	// Leave the iterator exhausted as if it had been drained with Next
+	generic.currentPage = lastPage
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	if firstErr != nil {
+		generic.err = firstErr
+		return nil, firstErr
+	}
+
+	var items []T
+	for _, page := range pages {
+		items = append(items, page...)
+	}
+	return items, nil
+}
+
+// MapAll drains it serially, returning fn applied to every item in page order. It stops
+// before fetching another page once ctx is done and returns the first error with no
+// items.
+func MapAll[T, R any](ctx context.Context, it Iterator[T], fn func(T) R) ([]R, error) {
+	var results []R
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var page Page[T]
+		more := it.Next(ctx, &page)
+		if err := it.Err(); err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			results = append(results, fn(item))
+		}
+		if !more {
+			return results, nil
+		}
+	}
+}
+
+// Collect drains it serially and returns every item in page order. It stops before
+// fetching another page once ctx is done and returns the first error with no items.
+func Collect[T any](ctx context.Context, it Iterator[T]) ([]T, error) {
+	return MapAll(ctx, it, func(item T) T { return item })
+}
+
+// ForEach calls fn with every item of it in page order, fetching pages as they are
+// needed so the full listing is never held in memory. It stops before fetching
+// another page once ctx is done. When fn returns ErrStopIteration iteration ends
+// with a nil error, and any other error from fn is returned immediately.
+func ForEach[T any](ctx context.Context, it Iterator[T], fn func(T) error) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var page Page[T]
+		more := it.Next(ctx, &page)
+		if err := it.Err(); err != nil {
+			return err
+		}
+		for _, item := range page.Items {
+			if err := fn(item); err != nil {
+				if errors.Is(err, ErrStopIteration) {
+					return nil
+				}
+				return err
+			}
+		}
+		if !more {
+			return nil
+		}
+	}
+}
+
+// errIterator is an Iterator that yields nothing and reports err
+type errIterator[T any] struct {
+	err error
+}
+
+// Next always returns false
+func (e *errIterator[T]) Next(ctx context.Context, page *Page[T]) bool {
+	return false
+}
+
+// Err returns the error the iterator was created with
+func (e *errIterator[T]) Err() error {
+	return e.err
+}
+
+// headerFetcher wraps a PageFetcher, adding a header to every page request
+type headerFetcher[T any] struct {
+	fetcher PageFetcher[T]
+	name    string
+	value   string
+}
+
+// FetchPage implements PageFetcher interface
+func (f *headerFetcher[T]) FetchPage(ctx context.Context, pageNum int) (*Page[T], error) {
+	return f.fetcher.FetchPage(WithHeader(ctx, f.name, f.value), pageNum)
+}
+
+// tally counts the items of it by key in a single pass. If iteration fails or ctx is
+// cancelled part way, the counts gathered so far are returned with the error.
+func tally[T any](ctx context.Context, it Iterator[T], key func(T) string) (map[string]int, error) {
+	counts := make(map[string]int)
+	err := ForEach(ctx, it, func(item T) error {
+		counts[key(item)]++
+		return nil
+	})
+	return counts, err
+}
@@ -3,6 +3,8 @@ package v1_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -14,9 +16,11 @@ import (
 type mockPageFetcher struct {
 	pages []v1.Page[v1.Post]
 	err   error
+	calls int
 }
 
 func (m *mockPageFetcher) FetchPage(ctx context.Context, pageNum int) (*v1.Page[v1.Post], error) {
+	m.calls++
 	if m.err != nil {
 		return nil, m.err
 	}
@@ -192,6 +196,81 @@ func TestGenericIteratorContextTimeout(t *testing.T) {
 	assert.ErrorIs(t, iterator.Err(), context.DeadlineExceeded)
 }
 
+// wrappingPageFetcher blocks until the context is done and returns its error
+// formatted by wrap, as fetchers that add context to errors might
+type wrappingPageFetcher struct {
+	wrap func(err error) error
+}
+
+func (f *wrappingPageFetcher) FetchPage(ctx context.Context, pageNum int) (*v1.Page[v1.Post], error) {
+	<-ctx.Done()
+	return nil, f.wrap(ctx.Err())
+}
+
+func TestGenericIteratorWrappedContextError(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		wrap func(err error) error
+	}{
+		{
+			name: "Wrapped",
+			wrap: func(err error) error { return fmt.Errorf("fetch page: %w", err) },
+		},
+		{
+			name: "Formatted",
+			wrap: func(err error) error { return fmt.Errorf("fetch page: %v", err) },
+		},
+		{
+			name: "Replaced",
+			wrap: func(err error) error { return errors.New("connection closed") },
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			fetcher := &wrappingPageFetcher{wrap: test.wrap}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(10*time.Millisecond, cancel)
+			iterator := v1.NewGenericIterator[v1.Post](fetcher)
+			var page v1.Page[v1.Post]
+			require.False(t, iterator.Next(ctx, &page))
+			assert.ErrorIs(t, iterator.Err(), context.Canceled)
+
+			ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			iterator = v1.NewGenericIterator[v1.Post](fetcher)
+			require.False(t, iterator.Next(ctx, &page))
+			assert.ErrorIs(t, iterator.Err(), context.DeadlineExceeded)
+		})
+	}
+}
+
+func TestCollectAllNWrappedContextError(t *testing.T) {
+	fetcher := &firstPageFetcher{
+		first: v1.Page[v1.Post]{Items: []v1.Post{{ID: "1"}}, Page: 1, TotalPages: 3},
+		rest: &wrappingPageFetcher{
+			wrap: func(err error) error { return fmt.Errorf("fetch page: %v", err) },
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := v1.CollectAllN(ctx, v1.NewGenericIterator[v1.Post](fetcher), 2)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// firstPageFetcher serves the first page itself and delegates the rest
+type firstPageFetcher struct {
+	first v1.Page[v1.Post]
+	rest  v1.PageFetcher[v1.Post]
+}
+
+func (f *firstPageFetcher) FetchPage(ctx context.Context, pageNum int) (*v1.Page[v1.Post], error) {
+	if pageNum == 1 {
+		return &f.first, nil
+	}
+	return f.rest.FetchPage(ctx, pageNum)
+}
+
 func TestGenericIteratorSinglePage(t *testing.T) {
 	// Test with single page
 	pages := []v1.Page[v1.Post]{
@@ -226,4 +305,475 @@ func TestGenericIteratorSinglePage(t *testing.T) {
 	hasMore = iterator.Next(ctx, &page2)
 	require.False(t, hasMore)
 	require.NoError(t, iterator.Err())
-}
\ No newline at end of file
+}
+
+// buildPages returns count pages of perPage posts each with sequential IDs
+func buildPages(count, perPage int) []v1.Page[v1.Post] {
+	pages := make([]v1.Page[v1.Post], count)
+	for p := 0; p < count; p++ {
+		items := make([]v1.Post, perPage)
+		for i := 0; i < perPage; i++ {
+			items[i] = v1.Post{ID: fmt.Sprintf("%d", p*perPage+i+1)}
+		}
+		pages[p] = v1.Page[v1.Post]{
+			Items:      items,
+			Total:      count * perPage,
+			Page:       p + 1,
+			PerPage:    perPage,
+			TotalPages: count,
+		}
+	}
+	return pages
+}
+
+func TestGenericIteratorMaxPages(t *testing.T) {
+	fetcher := &mockPageFetcher{pages: buildPages(5, 10)}
+	iterator := v1.NewGenericIterator[v1.Post](fetcher)
+	iterator.SetMaxPages(2)
+
+	ctx := context.Background()
+
+	var page1 v1.Page[v1.Post]
+	require.True(t, iterator.Next(ctx, &page1))
+	require.NoError(t, iterator.Err())
+	assert.Equal(t, 1, page1.Page)
+
+	// The bound is reached so more pages are not reported
+	var page2 v1.Page[v1.Post]
+	require.False(t, iterator.Next(ctx, &page2))
+	require.NoError(t, iterator.Err())
+	assert.Equal(t, 2, page2.Page)
+
+	var page3 v1.Page[v1.Post]
+	require.False(t, iterator.Next(ctx, &page3))
+	require.NoError(t, iterator.Err())
+	assert.Empty(t, page3.Items)
+	assert.Equal(t, 2, fetcher.calls)
+}
+
+func TestGenericIteratorMaxPagesWithLimit(t *testing.T) {
+	fetcher := &mockPageFetcher{pages: buildPages(5, 10)}
+	generic := v1.NewGenericIterator[v1.Post](fetcher)
+	generic.SetMaxPages(2)
+	iterator := v1.LimitIterator[v1.Post](generic, 100)
+
+	var total int
+	ctx := context.Background()
+	for {
+		var page v1.Page[v1.Post]
+		more := iterator.Next(ctx, &page)
+		require.NoError(t, iterator.Err())
+		total += len(page.Items)
+		if !more {
+			break
+		}
+	}
+
+	// The page bound is reached before the item bound
+	assert.Equal(t, 20, total)
+	assert.Equal(t, 2, fetcher.calls)
+}
+
+func TestRestoreIterator(t *testing.T) {
+	pages := buildPages(4, 5)
+	ctx := context.Background()
+
+	// Fetch the first two pages and checkpoint
+	iterator := v1.NewGenericIterator[v1.Post](&mockPageFetcher{pages: pages})
+	var ids []string
+	for range 2 {
+		var page v1.Page[v1.Post]
+		require.True(t, iterator.Next(ctx, &page))
+		for _, post := range page.Items {
+			ids = append(ids, post.ID)
+		}
+	}
+	token := iterator.State()
+
+	// Resume with a new fetcher as a restarted worker would
+	fetcher := &mockPageFetcher{pages: pages}
+	restored, err := v1.RestoreIterator[v1.Post](fetcher, token)
+	require.NoError(t, err)
+
+	rest, err := v1.CollectAllN[v1.Post](ctx, restored, 1)
+	require.NoError(t, err)
+	for _, post := range rest {
+		ids = append(ids, post.ID)
+	}
+
+	require.Len(t, ids, 20)
+	for i, id := range ids {
+		assert.Equal(t, fmt.Sprintf("%d", i+1), id)
+	}
+	assert.Equal(t, 2, fetcher.calls)
+
+	// A finished iterator restores as finished
+	finished, err := v1.RestoreIterator[v1.Post](fetcher, restored.State())
+	require.NoError(t, err)
+	var page v1.Page[v1.Post]
+	assert.False(t, finished.Next(ctx, &page))
+	assert.Equal(t, 2, fetcher.calls)
+}
+
+func TestRestoreIteratorAfterFailedPage(t *testing.T) {
+	pages := buildPages(4, 2)
+	ctx := context.Background()
+
+	// Page 3 fails, so the checkpoint must still point at it
+	iterator := v1.NewGenericIterator[v1.Post](&concurrentPageFetcher{pages: pages, failPage: 3})
+	for range 2 {
+		var page v1.Page[v1.Post]
+		require.True(t, iterator.Next(ctx, &page))
+	}
+	var page v1.Page[v1.Post]
+	require.False(t, iterator.Next(ctx, &page))
+	require.EqualError(t, iterator.Err(), "page 3 failed")
+	token := iterator.State()
+
+	fetcher := &mockPageFetcher{pages: pages}
+	restored, err := v1.RestoreIterator[v1.Post](fetcher, token)
+	require.NoError(t, err)
+
+	var ids []string
+	require.True(t, restored.Next(ctx, &page))
+	assert.Equal(t, 3, page.Page)
+	for _, post := range page.Items {
+		ids = append(ids, post.ID)
+	}
+	require.False(t, restored.Next(ctx, &page))
+	require.NoError(t, restored.Err())
+	for _, post := range page.Items {
+		ids = append(ids, post.ID)
+	}
+	assert.Equal(t, []string{"5", "6", "7", "8"}, ids)
+	assert.Equal(t, 2, fetcher.calls)
+}
+
+func TestRestoreIteratorInvalidToken(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		token string
+	}{
+		{name: "NotBase64", token: "not a token!"},
+		{name: "NotJSON", token: "bm90IGpzb24"},
+		{name: "NegativePage", token: "eyJwYWdlIjotMSwidG90YWxfcGFnZXMiOjJ9"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := v1.RestoreIterator[v1.Post](&mockPageFetcher{}, test.token)
+			require.ErrorContains(t, err, "invalid iterator state")
+		})
+	}
+}
+
+func TestLimitIterator(t *testing.T) {
+	fetcher := &mockPageFetcher{pages: buildPages(3, 10)}
+	iterator := v1.LimitIterator[v1.Post](v1.NewGenericIterator[v1.Post](fetcher), 15)
+
+	ctx := context.Background()
+
+	var page1 v1.Page[v1.Post]
+	hasMore := iterator.Next(ctx, &page1)
+	require.True(t, hasMore)
+	require.NoError(t, iterator.Err())
+	assert.Len(t, page1.Items, 10)
+
+	// The limit falls mid-page so the second page is trimmed
+	var page2 v1.Page[v1.Post]
+	hasMore = iterator.Next(ctx, &page2)
+	require.False(t, hasMore)
+	require.NoError(t, iterator.Err())
+	require.Len(t, page2.Items, 5)
+	assert.Equal(t, "11", page2.Items[0].ID)
+	assert.Equal(t, "15", page2.Items[4].ID)
+
+	var page3 v1.Page[v1.Post]
+	hasMore = iterator.Next(ctx, &page3)
+	require.False(t, hasMore)
+	require.NoError(t, iterator.Err())
+	assert.Empty(t, page3.Items)
+
+	// The third page is never fetched
+	assert.Equal(t, 2, fetcher.calls)
+}
+
+func TestLimitIteratorPageBoundary(t *testing.T) {
+	fetcher := &mockPageFetcher{pages: buildPages(3, 10)}
+	iterator := v1.LimitIterator[v1.Post](v1.NewGenericIterator[v1.Post](fetcher), 10)
+
+	ctx := context.Background()
+
+	var page v1.Page[v1.Post]
+	hasMore := iterator.Next(ctx, &page)
+	require.False(t, hasMore)
+	require.NoError(t, iterator.Err())
+	assert.Len(t, page.Items, 10)
+	assert.Equal(t, 1, fetcher.calls)
+}
+
+func TestLimitIteratorFewerItems(t *testing.T) {
+	fetcher := &mockPageFetcher{pages: buildPages(2, 10)}
+	iterator := v1.LimitIterator[v1.Post](v1.NewGenericIterator[v1.Post](fetcher), 50)
+
+	ctx := context.Background()
+
+	var total int
+	for {
+		var page v1.Page[v1.Post]
+		hasMore := iterator.Next(ctx, &page)
+		require.NoError(t, iterator.Err())
+		total += len(page.Items)
+		if !hasMore {
+			break
+		}
+	}
+	assert.Equal(t, 20, total)
+	assert.Equal(t, 2, fetcher.calls)
+}
+
+// concurrentPageFetcher is safe for concurrent use and records the peak number of
+// in-flight fetches
+type concurrentPageFetcher struct {
+	pages       []v1.Page[v1.Post]
+	delay       time.Duration
+	failPage    int
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (f *concurrentPageFetcher) FetchPage(ctx context.Context, pageNum int) (*v1.Page[v1.Post], error) {
+	n := f.inFlight.Add(1)
+	defer f.inFlight.Add(-1)
+	for {
+		peak := f.maxInFlight.Load()
+		if n <= peak || f.maxInFlight.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	select {
+	case <-time.After(f.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if pageNum == f.failPage {
+		return nil, fmt.Errorf("page %d failed", pageNum)
+	}
+	return &f.pages[pageNum-1], nil
+}
+
+func TestCollectAllN(t *testing.T) {
+	fetcher := &concurrentPageFetcher{pages: buildPages(6, 10), delay: 10 * time.Millisecond}
+
+	items, err := v1.CollectAllN[v1.Post](context.Background(), v1.NewGenericIterator[v1.Post](fetcher), 3)
+	require.NoError(t, err)
+	require.Len(t, items, 60)
+	for i, item := range items {
+		assert.Equal(t, fmt.Sprintf("%d", i+1), item.ID)
+	}
+	assert.LessOrEqual(t, fetcher.maxInFlight.Load(), int32(3))
+	assert.Greater(t, fetcher.maxInFlight.Load(), int32(1))
+}
+
+func TestCollectAllNError(t *testing.T) {
+	fetcher := &concurrentPageFetcher{pages: buildPages(6, 10), delay: 5 * time.Millisecond, failPage: 4}
+	iterator := v1.NewGenericIterator[v1.Post](fetcher)
+
+	items, err := v1.CollectAllN[v1.Post](context.Background(), iterator, 3)
+	require.EqualError(t, err, "page 4 failed")
+	assert.Nil(t, items)
+	assert.Equal(t, err, iterator.Err())
+}
+
+func TestCollectAllNSerial(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		iterator    func(v1.PageFetcher[v1.Post]) v1.Iterator[v1.Post]
+		concurrency int
+	}{
+		{
+			name: "ConcurrencyOne",
+			iterator: func(f v1.PageFetcher[v1.Post]) v1.Iterator[v1.Post] {
+				return v1.NewGenericIterator[v1.Post](f)
+			},
+			concurrency: 1,
+		},
+		{
+			name: "WrappedIterator",
+			iterator: func(f v1.PageFetcher[v1.Post]) v1.Iterator[v1.Post] {
+				return v1.LimitIterator[v1.Post](v1.NewGenericIterator[v1.Post](f), 100)
+			},
+			concurrency: 4,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			fetcher := &concurrentPageFetcher{pages: buildPages(3, 10)}
+
+			items, err := v1.CollectAllN(context.Background(), test.iterator(fetcher), test.concurrency)
+			require.NoError(t, err)
+			assert.Len(t, items, 30)
+			assert.Equal(t, int32(1), fetcher.maxInFlight.Load())
+		})
+	}
+}
+
+func TestCollectAllNContextCancellation(t *testing.T) {
+	fetcher := &concurrentPageFetcher{pages: buildPages(6, 10), delay: 20 * time.Millisecond}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	items, err := v1.CollectAllN[v1.Post](ctx, v1.NewGenericIterator[v1.Post](fetcher), 2)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, items)
+}
+
+func BenchmarkCollectAllN(b *testing.B) {
+	for _, concurrency := range []int{1, 4, 8} {
+		b.Run(fmt.Sprintf("Concurrency%d", concurrency), func(b *testing.B) {
+			pages := buildPages(20, 10)
+			for i := 0; i < b.N; i++ {
+				fetcher := &concurrentPageFetcher{pages: pages, delay: time.Millisecond}
+				_, err := v1.CollectAllN[v1.Post](context.Background(), v1.NewGenericIterator[v1.Post](fetcher), concurrency)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestMapAll(t *testing.T) {
+	type summary struct {
+		ID     string
+		Length int
+	}
+
+	pages := buildPages(3, 2)
+	pages[1].Items[0].Text = "Hello"
+	fetcher := &mockPageFetcher{pages: pages}
+
+	summaries, err := v1.MapAll(context.Background(), v1.NewGenericIterator[v1.Post](fetcher), func(post v1.Post) summary {
+		return summary{ID: post.ID, Length: len(post.Text)}
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []summary{
+		{ID: "1"}, {ID: "2"}, {ID: "3", Length: 5}, {ID: "4"}, {ID: "5"}, {ID: "6"},
+	}, summaries)
+	assert.Equal(t, 3, fetcher.calls)
+}
+
+func TestMapAllError(t *testing.T) {
+	expectedErr := errors.New("fetch failed")
+	fetcher := &mockPageFetcher{err: expectedErr}
+
+	summaries, err := v1.MapAll(context.Background(), v1.NewGenericIterator[v1.Post](fetcher), func(post v1.Post) string {
+		return post.ID
+	})
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Nil(t, summaries)
+}
+
+func TestMapAllContextCancellation(t *testing.T) {
+	fetcher := &mockPageFetcher{pages: buildPages(3, 2)}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Cancelling while mapping the first page stops before the next fetch
+	summaries, err := v1.MapAll(ctx, v1.NewGenericIterator[v1.Post](fetcher), func(post v1.Post) string {
+		cancel()
+		return post.ID
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, summaries)
+	assert.Equal(t, 1, fetcher.calls)
+}
+
+func TestForEach(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		fn            func(post v1.Post) error
+		expectedErr   string
+		expectedIDs   []string
+		expectedCalls int
+	}{
+		{
+			name:          "Completion",
+			fn:            func(post v1.Post) error { return nil },
+			expectedIDs:   []string{"1", "2", "3", "4", "5", "6"},
+			expectedCalls: 3,
+		},
+		{
+			name: "EarlyStop",
+			fn: func(post v1.Post) error {
+				if post.ID == "3" {
+					return v1.ErrStopIteration
+				}
+				return nil
+			},
+			expectedIDs:   []string{"1", "2", "3"},
+			expectedCalls: 2,
+		},
+		{
+			name: "WrappedStop",
+			fn: func(post v1.Post) error {
+				return fmt.Errorf("done: %w", v1.ErrStopIteration)
+			},
+			expectedIDs:   []string{"1"},
+			expectedCalls: 1,
+		},
+		{
+			name: "CallbackError",
+			fn: func(post v1.Post) error {
+				if post.ID == "4" {
+					return errors.New("failed to process post 4")
+				}
+				return nil
+			},
+			expectedErr:   "failed to process post 4",
+			expectedIDs:   []string{"1", "2", "3", "4"},
+			expectedCalls: 2,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			fetcher := &mockPageFetcher{pages: buildPages(3, 2)}
+
+			var ids []string
+			err := v1.ForEach(context.Background(), v1.NewGenericIterator[v1.Post](fetcher), func(post v1.Post) error {
+				ids = append(ids, post.ID)
+				return test.fn(post)
+			})
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedIDs, ids)
+			assert.Equal(t, test.expectedCalls, fetcher.calls)
+		})
+	}
+}
+
+func TestForEachIteratorError(t *testing.T) {
+	fetcher := &mockPageFetcher{err: errors.New("fetch failed")}
+
+	var calls int
+	err := v1.ForEach(context.Background(), v1.NewGenericIterator[v1.Post](fetcher), func(post v1.Post) error {
+		calls++
+		return nil
+	})
+	require.EqualError(t, err, "fetch failed")
+	assert.Zero(t, calls)
+}
+
+func TestForEachContextCancellation(t *testing.T) {
+	fetcher := &mockPageFetcher{pages: buildPages(3, 2)}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Cancelling while handling the first page stops before the next fetch
+	err := v1.ForEach(ctx, v1.NewGenericIterator[v1.Post](fetcher), func(post v1.Post) error {
+		cancel()
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, fetcher.calls)
+}
@@ -0,0 +1,17 @@
+package v1
+
+import "io"
+
+// UploadMediaRequest represents a media file upload
+type UploadMediaRequest struct {
+	Filename string
+	Content  io.Reader
+}
+
+// UploadMediaResponse describes an uploaded media file hosted by Publer
+type UploadMediaResponse struct {
+	ID       string `json:"id"`
+	URL      string `json:"url"`
+	Filename string `json:"filename"`
+	Size     int64  `json:"size"`
+}
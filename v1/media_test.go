@@ -0,0 +1,93 @@
+package v1_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/thrawn/publer.go/v1"
+)
+
+func TestUploadMedia(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	var resp v1.UploadMediaResponse
+	err := client.UploadMedia(context.Background(), v1.UploadMediaRequest{
+		Filename: "photo.jpg",
+		Content:  bytes.NewReader(make([]byte, 2048)),
+	}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "media-1", resp.ID)
+	assert.Equal(t, "https://cdn.publer.mock/media/media-1/photo.jpg", resp.URL)
+	assert.Equal(t, "photo.jpg", resp.Filename)
+	assert.Equal(t, int64(2048), resp.Size)
+
+	err = client.UploadMedia(context.Background(), v1.UploadMediaRequest{
+		Filename: "clip.mp4",
+		Content:  strings.NewReader("video-bytes"),
+	}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "media-2", resp.ID)
+
+	assert.Equal(t, []v1.MockUpload{
+		{Filename: "photo.jpg", Size: 2048},
+		{Filename: "clip.mp4", Size: 11},
+	}, server.Uploads())
+}
+
+func TestUploadMediaTooLarge(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetMaxUploadBytes(1024)
+
+	var resp v1.UploadMediaResponse
+	err := client.UploadMedia(context.Background(), v1.UploadMediaRequest{
+		Filename: "large.jpg",
+		Content:  bytes.NewReader(make([]byte, 1025)),
+	}, &resp)
+	require.Error(t, err)
+
+	var apiErr *v1.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 413, apiErr.StatusCode)
+	assert.Contains(t, apiErr.Message, "exceeding the limit of 1024 bytes")
+	assert.Empty(t, server.Uploads())
+
+	// Files at the limit are accepted
+	err = client.UploadMedia(context.Background(), v1.UploadMediaRequest{
+		Filename: "exact.jpg",
+		Content:  bytes.NewReader(make([]byte, 1024)),
+	}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1024), resp.Size)
+}
+
+func TestUploadMediaValidation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	var resp v1.UploadMediaResponse
+	err := client.UploadMedia(context.Background(), v1.UploadMediaRequest{
+		Content: strings.NewReader("data"),
+	}, &resp)
+	require.ErrorContains(t, err, "filename is required")
+
+	err = client.UploadMedia(context.Background(), v1.UploadMediaRequest{
+		Filename: "photo.jpg",
+	}, &resp)
+	require.ErrorContains(t, err, "content is required")
+}
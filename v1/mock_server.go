@@ -1,11 +1,14 @@
 package v1
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -25,13 +28,65 @@ type MockServer struct {
 	jobProgression   map[string][]JobStatus
 	jobProgressIndex map[string]int
 	posts            []Post
+	trashedPosts     []trashedPost
 	accounts         []Account
 	workspaces       []Workspace
+	templates        []Template
+	webhooks         []Webhook
+	postWorkspaces   map[string]string
 	currentUser      *User
+	accountLimit     int
+	rateLimit        int
+	rateRemaining    int
+	rateReset        time.Time
+	requestLimit     int
+	requestWindow    time.Duration
+	requestTimes     []time.Time
+	dailyUsage       UsageResponse
+	comments         map[string][]Comment
+	bestTimes        map[string][]TimeSlot
+	analytics        map[string]PostAnalytics
+	series           map[string]mockSeries
+	quietHours       map[string]QuietHours
 	responses        map[string]MockResponse
 	errorResponses   map[string]MockErrorResponse
+	transientErrors  map[string]transientError
 	callCounts       map[string]int
 	bulkOpLimit      int
+	strictValidation bool
+	persistPosts     bool
+	now              func() time.Time
+	uploads          []MockUpload
+	features         map[string]bool
+	workspaceHeaders []string
+	maxUploadBytes   int64
+}
+
+// MockUpload records a media file received by the upload endpoint
+type MockUpload struct {
+	Filename string
+	Size     int64
+}
+
+// trashedPost holds a deleted post along with the state it had before deletion
+type trashedPost struct {
+	post          Post
+	previousState string
+}
+
+// mockSeries holds a recurring series request along with when the series started
+type mockSeries struct {
+	request RecurringPostRequest
+	start   time.Time
+}
+
+// maxMockSeriesOccurrences bounds the occurrences generated for a series with no end
+const maxMockSeriesOccurrences = 50
+
+// transientError fails the next remaining calls to an endpoint with statusCode
+type transientError struct {
+	statusCode int
+	remaining  int
 }
 
 // MockResponse holds configured response data
@@ -60,7 +115,15 @@ func SpawnMockServer() *MockServer {
 		jobProgressIndex: make(map[string]int),
 		responses:        make(map[string]MockResponse),
 		errorResponses:   make(map[string]MockErrorResponse),
+		transientErrors:  make(map[string]transientError),
 		callCounts:       make(map[string]int),
+		comments:         make(map[string][]Comment),
+		bestTimes:        make(map[string][]TimeSlot),
+		analytics:        make(map[string]PostAnalytics),
+		series:           make(map[string]mockSeries),
+		quietHours:       make(map[string]QuietHours),
+		postWorkspaces:   make(map[string]string),
+		now:              time.Now,
 	}
 
 	m.server = httptest.NewServer(http.HandlerFunc(m.handleRequest))
@@ -69,11 +132,17 @@ func SpawnMockServer() *MockServer {
 
 // Client returns a new Client instance configured to use this mock server
 func (m *MockServer) Client() *Client {
-	client, _ := NewClient(Config{
-		APIKey:      m.apiKey,
-		WorkspaceID: m.workspaceID,
-		BaseURL:     m.server.URL + "/api/v1/",
-	})
+	return m.ClientWithConfig(Config{})
+}
+
+// ClientWithConfig returns a new Client using the provided config with the
+// credentials and base URL of this mock server filled in
+func (m *MockServer) ClientWithConfig(config Config) *Client {
+	config.APIKey = m.apiKey
+	config.WorkspaceID = m.workspaceID
+	config.BaseURL = m.server.URL + "/api/v1/"
+
+	client, _ := NewClient(config)
 	return client
 }
 
@@ -98,13 +167,38 @@ func (m *MockServer) Reset() {
 	m.jobProgression = make(map[string][]JobStatus)
 	m.jobProgressIndex = make(map[string]int)
 	m.posts = []Post{}
+	m.trashedPosts = nil
 	m.accounts = []Account{}
 	m.workspaces = []Workspace{}
+	m.templates = nil
+	m.webhooks = nil
 	m.currentUser = nil
+	m.accountLimit = 0
+	m.rateLimit = 0
+	m.rateRemaining = 0
+	m.rateReset = time.Time{}
+	m.requestLimit = 0
+	m.requestWindow = 0
+	m.requestTimes = nil
+	m.dailyUsage = UsageResponse{}
+	m.comments = make(map[string][]Comment)
+	m.bestTimes = make(map[string][]TimeSlot)
+	m.analytics = make(map[string]PostAnalytics)
+	m.series = make(map[string]mockSeries)
+	m.quietHours = make(map[string]QuietHours)
+	m.postWorkspaces = make(map[string]string)
 	m.responses = make(map[string]MockResponse)
 	m.errorResponses = make(map[string]MockErrorResponse)
+	m.transientErrors = make(map[string]transientError)
 	m.callCounts = make(map[string]int)
 	m.jobDelay = 0
+	m.strictValidation = false
+	m.persistPosts = false
+	m.now = time.Now
+	m.uploads = nil
+	m.features = nil
+	m.workspaceHeaders = nil
+	m.maxUploadBytes = 0
 }
 
 // SetResponse configures expected response for specific endpoint
@@ -119,7 +213,8 @@ func (m *MockServer) SetResponse(method, path string, statusCode int, body any)
 	}
 }
 
-// SetErrorResponse configures error response after N calls to endpoint
+// SetErrorResponse configures error response after N calls to endpoint.
+// A []byte body is written verbatim, other bodies are encoded as JSON.
 func (m *MockServer) SetErrorResponse(method, path string, callThreshold int, statusCode int, body any, headers map[string]string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -134,6 +229,28 @@ func (m *MockServer) SetErrorResponse(method, path string, callThreshold int, st
 	}
 }
 
+// SetTransientError makes the next N calls to the endpoint fail with the given
+// status code, after which the endpoint responds normally again
+func (m *MockServer) SetTransientError(method, path string, failures int, statusCode int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := fmt.Sprintf("%s %s", method, path)
+	m.transientErrors[key] = transientError{statusCode: statusCode, remaining: failures}
+}
+
+// writeMockBody writes a configured response body; []byte bodies are written as-is
+// while anything else is encoded as JSON
+func writeMockBody(w http.ResponseWriter, body any) {
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		_, _ = w.Write(b)
+	default:
+		_ = json.NewEncoder(w).Encode(b)
+	}
+}
+
 // SetJobStatus configures job status response for job ID
 func (m *MockServer) SetJobStatus(jobID, status string, progress int, result *JobResult, err string) {
 	m.mu.Lock()
@@ -177,6 +294,159 @@ func (m *MockServer) AdvanceJobState(jobID string) bool {
 	return false
 }
 
+// SetStrictValidation enables required field validation on publish requests
+func (m *MockServer) SetStrictValidation(enabled bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.strictValidation = enabled
+}
+
+// SetPersistCreatedPosts makes publish, schedule and draft requests store the created
+// posts and complete their jobs immediately with the new post IDs
+func (m *MockServer) SetPersistCreatedPosts(enabled bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.persistPosts = enabled
+}
+
+// createPosts stores one post per account based on the template and returns the new post IDs.
+// When variants contains the network of an account, its text replaces the template text.
+func (m *MockServer) createPosts(template Post, accounts []string, variants map[string]string) []string {
+	seed := time.Now().UnixNano()
+	postIDs := make([]string, 0, len(accounts))
+	for i, accountID := range accounts {
+		post := template
+		post.ID = fmt.Sprintf("post-%d-%d", seed, i)
+		post.AccountID = accountID
+		post.CreatedAt = m.now()
+		if post.Thumbnail == "" && len(post.Media) > 0 {
+			post.Thumbnail = post.Media[0].URL
+		}
+		for _, account := range m.accounts {
+			if account.ID == accountID {
+				post.Network = account.Provider
+				break
+			}
+		}
+		if text, ok := variants[post.Network]; ok {
+			post.Text = text
+		}
+
+		m.posts = append(m.posts, post)
+		postIDs = append(postIDs, post.ID)
+	}
+	return postIDs
+}
+
+// completeJob marks a job as completed with the given post IDs
+func (m *MockServer) completeJob(jobID string, postIDs []string) {
+	m.jobs[jobID] = &JobStatus{
+		ID:       jobID,
+		Status:   "completed",
+		Progress: 100,
+		Result: &JobResult{
+			Success: true,
+			PostIDs: postIDs,
+		},
+	}
+}
+
+// SetNow overrides the clock used to validate scheduled times
+func (m *MockServer) SetNow(now func() time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.now = now
+}
+
+// inWorkspace reports whether a post is visible to a request for workspaceID. Posts
+// are shared by every workspace until moved with POST /api/v1/posts/{id}/move-workspace,
+// after which only the target workspace sees them.
+func (m *MockServer) inWorkspace(postID, workspaceID string) bool {
+	moved, ok := m.postWorkspaces[postID]
+	return !ok || moved == workspaceID
+}
+
+// WorkspaceID returns the workspace ID that clients created by the mock are configured with
+func (m *MockServer) WorkspaceID() string {
+	return m.workspaceID
+}
+
+// WorkspaceHeaders returns the Publer-Workspace-Id header of every request received in order
+func (m *MockServer) WorkspaceHeaders() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return append([]string{}, m.workspaceHeaders...)
+}
+
+// knownWorkspace reports whether id is the mock's own workspace or one added with
+// AddWorkspace or AddWorkspaces
+func (m *MockServer) knownWorkspace(id string) bool {
+	if id == m.workspaceID {
+		return true
+	}
+	for _, workspace := range m.workspaces {
+		if id != "" && workspace.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// allowRequest records a request made at now, reporting false when the requests made
+// within the last window already reach the limit set by SetRequestLimit. Callers must
+// hold m.mu.
+func (m *MockServer) allowRequest(now time.Time) bool {
+	cutoff := now.Add(-m.requestWindow)
+	recent := m.requestTimes[:0]
+	for _, at := range m.requestTimes {
+		if at.After(cutoff) {
+			recent = append(recent, at)
+		}
+	}
+	m.requestTimes = recent
+	if len(m.requestTimes) >= m.requestLimit {
+		return false
+	}
+	m.requestTimes = append(m.requestTimes, now)
+	return true
+}
+
+// SetFeatures seeds the features reported for the workspace plan
+func (m *MockServer) SetFeatures(features map[string]bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.features = features
+}
+
+// SetMaxUploadBytes rejects media uploads larger than limit bytes; 0 disables the limit
+func (m *MockServer) SetMaxUploadBytes(limit int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.maxUploadBytes = limit
+}
+
+// Uploads returns the media files received by the upload endpoint in order
+func (m *MockServer) Uploads() []MockUpload {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return append([]MockUpload{}, m.uploads...)
+}
+
+// CallCount returns how many requests the mock has received for method and path
+func (m *MockServer) CallCount(method, path string) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.callCounts[fmt.Sprintf("%s %s", method, path)]
+}
+
 // SetDelay adds artificial delay to responses (bypassed in fast test mode)
 func (m *MockServer) SetDelay(delay time.Duration) {
 	m.mu.Lock()
@@ -209,6 +479,142 @@ func (m *MockServer) AddWorkspaces(workspaces []Workspace) {
 	m.workspaces = append(m.workspaces, workspaces...)
 }
 
+// AddTemplate adds a saved post template to mock data
+func (m *MockServer) AddTemplate(template Template) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.templates = append(m.templates, template)
+}
+
+// SetBestTimes seeds the slots served by GET /api/v1/accounts/{id}/best-times
+func (m *MockServer) SetBestTimes(accountID string, slots []TimeSlot) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.bestTimes[accountID] = slots
+}
+
+// SetPostAnalytics seeds the metrics served by GET /api/v1/posts/{id}/analytics
+func (m *MockServer) SetPostAnalytics(postID string, analytics PostAnalytics) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.analytics[postID] = analytics
+}
+
+// SetPostComments seeds the comments served for a post by GET /api/v1/posts/{id}/comments
+func (m *MockServer) SetPostComments(postID string, comments []Comment) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.comments[postID] = comments
+}
+
+// SetRateLimit makes every authenticated response report the given X-RateLimit-Limit
+// and X-RateLimit-Remaining headers. A limit of 0 omits the headers.
+func (m *MockServer) SetRateLimit(limit, remaining int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rateLimit = limit
+	m.rateRemaining = remaining
+}
+
+// SetRateLimitReset makes responses carrying rate limit headers also report reset as
+// the X-RateLimit-Reset header in Unix seconds. A zero reset omits the header.
+func (m *MockServer) SetRateLimitReset(reset time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rateReset = reset
+}
+
+// SetRequestLimit enforces a limit of requests per sliding window, answering any
+// authenticated request beyond it with 429. The window is measured in wall clock
+// time regardless of SetNow. A limit of 0 removes the enforcement.
+func (m *MockServer) SetRequestLimit(requests int, window time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requestLimit = requests
+	m.requestWindow = window
+	m.requestTimes = nil
+}
+
+// SetDailyUsage seeds the daily post usage reported by GET /api/v1/users/me/usage
+func (m *MockServer) SetDailyUsage(used, limit int, resetsAt time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.dailyUsage = UsageResponse{
+		DailyPostsUsed:  used,
+		DailyPostsLimit: limit,
+		ResetsAt:        resetsAt,
+	}
+}
+
+// SetAccountSchedule sets the quiet hours of an account, honored by publish and
+// schedule requests with RespectQuietHours
+func (m *MockServer) SetAccountSchedule(accountID string, quiet QuietHours) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.quietHours[accountID] = quiet
+}
+
+// afterQuietHours returns the earliest time at or after t that is outside the quiet
+// hours of every account
+func (m *MockServer) afterQuietHours(t time.Time, accounts []string) time.Time {
+	// Each shift lands at the end of a window, so one pass per account settles it
+	for range accounts {
+		for _, accountID := range accounts {
+			if quiet, ok := m.quietHours[accountID]; ok {
+				t = quietHoursEnd(t, quiet)
+			}
+		}
+	}
+	return t
+}
+
+// quietHoursEnd returns the end of the quiet window containing t, or t when it is
+// outside the window
+func quietHoursEnd(t time.Time, quiet QuietHours) time.Time {
+	start, err := time.Parse("15:04", quiet.Start)
+	if err != nil {
+		return t
+	}
+	end, err := time.Parse("15:04", quiet.End)
+	if err != nil {
+		return t
+	}
+
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	startAt := day.Add(time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute)
+	endAt := day.Add(time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute)
+
+	switch {
+	case !endAt.Before(startAt):
+		if !t.Before(startAt) && t.Before(endAt) {
+			return endAt
+		}
+	case !t.Before(startAt):
+		return endAt.AddDate(0, 0, 1)
+	case t.Before(endAt):
+		return endAt
+	}
+	return t
+}
+
+// SetAccountLimit sets the plan account limit reported by GET /api/v1/users/me
+func (m *MockServer) SetAccountLimit(limit int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.accountLimit = limit
+}
+
 // SetCurrentUser sets the mock current user
 func (m *MockServer) SetCurrentUser(user User) {
 	m.mu.Lock()
@@ -260,7 +666,8 @@ func (m *MockServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 	}
 
 	workspaceHeader := r.Header.Get("Publer-Workspace-Id")
-	if workspaceHeader != m.workspaceID {
+	m.workspaceHeaders = append(m.workspaceHeaders, workspaceHeader)
+	if !m.knownWorkspace(workspaceHeader) {
 		w.WriteHeader(http.StatusBadRequest)
 		_ = json.NewEncoder(w).Encode(ErrorResponse{
 			Error:   "bad_request",
@@ -269,10 +676,39 @@ func (m *MockServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if m.rateLimit > 0 {
+		w.Header().Set("X-RateLimit-Limit", strconv.Itoa(m.rateLimit))
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(m.rateRemaining))
+		if !m.rateReset.IsZero() {
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(m.rateReset.Unix(), 10))
+		}
+	}
+
+	if m.requestLimit > 0 && !m.allowRequest(time.Now()) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "rate_limit_exceeded",
+			Message: "Too many requests",
+		})
+		return
+	}
+
 	// Track call counts
 	key := fmt.Sprintf("%s %s", r.Method, r.URL.Path)
 	m.callCounts[key]++
 
+	// Check for transient failures that recover after N calls
+	if transient := m.transientErrors[key]; transient.remaining > 0 {
+		transient.remaining--
+		m.transientErrors[key] = transient
+		w.WriteHeader(transient.statusCode)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "server_error",
+			Message: "Transient failure",
+		})
+		return
+	}
+
 	// Check for error response configuration
 	if errResp, exists := m.errorResponses[key]; exists {
 		if m.callCounts[key] >= errResp.CallThreshold {
@@ -282,9 +718,7 @@ func (m *MockServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 			}
 
 			w.WriteHeader(errResp.StatusCode)
-			if errResp.Body != nil {
-				_ = json.NewEncoder(w).Encode(errResp.Body)
-			}
+			writeMockBody(w, errResp.Body)
 			return
 		}
 	}
@@ -292,13 +726,15 @@ func (m *MockServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 	// Check for configured response
 	if resp, exists := m.responses[key]; exists {
 		w.WriteHeader(resp.StatusCode)
-		if resp.Body != nil {
-			_ = json.NewEncoder(w).Encode(resp.Body)
-		}
+		writeMockBody(w, resp.Body)
 		return
 	}
 
 	// Handle job status requests
+	if r.URL.Path == "/api/v1/job_status" && r.Method == "GET" {
+		m.handleJobStatuses(w, r)
+		return
+	}
 	if strings.HasPrefix(r.URL.Path, "/api/v1/job_status/") {
 		m.handleJobStatus(w, r)
 		return
@@ -328,6 +764,11 @@ func (m *MockServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if strings.HasPrefix(r.URL.Path, "/api/v1/posts/recurring/") && len(strings.Split(r.URL.Path, "/")) == 6 && r.Method == "GET" {
+		m.handleGetSeries(w, r, strings.Split(r.URL.Path, "/")[5])
+		return
+	}
+
 	// Handle auto-scheduling
 	if r.URL.Path == "/api/v1/posts/auto-schedule" && r.Method == "POST" {
 		m.handleAutoSchedulePost(w, r)
@@ -340,6 +781,18 @@ func (m *MockServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Handle duplicate checks
+	if r.URL.Path == "/api/v1/posts/duplicate_check" && r.Method == "POST" {
+		m.handleDuplicateCheck(w, r)
+		return
+	}
+
+	// Handle bulk label changes
+	if r.URL.Path == "/api/v1/posts/labels" && r.Method == "PATCH" {
+		m.handleUpdateLabels(w, r)
+		return
+	}
+
 	// Handle post management operations
 	if strings.HasPrefix(r.URL.Path, "/api/v1/posts/") && len(strings.Split(r.URL.Path, "/")) == 5 {
 		// Extract post ID from path: /api/v1/posts/{id}
@@ -359,7 +812,45 @@ func (m *MockServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Handle post sub-resource operations: /api/v1/posts/{id}/{action}
+	if strings.HasPrefix(r.URL.Path, "/api/v1/posts/") && len(strings.Split(r.URL.Path, "/")) == 6 {
+		parts := strings.Split(r.URL.Path, "/")
+		postID := parts[4]
+
+		switch {
+		case parts[5] == "restore" && r.Method == "POST":
+			m.handleRestorePost(w, r, postID)
+			return
+		case parts[5] == "comments" && r.Method == "GET":
+			m.handlePostComments(w, r, postID)
+			return
+		case parts[5] == "move-workspace" && r.Method == "POST":
+			m.handleMovePostToWorkspace(w, r, postID)
+			return
+		case parts[5] == "analytics" && r.Method == "GET":
+			m.handlePostAnalytics(w, r, postID)
+			return
+		case (parts[5] == "pin" || parts[5] == "unpin") && r.Method == "POST":
+			m.handlePinPost(w, r, postID, parts[5] == "pin")
+			return
+		}
+	}
+
+	// Handle comment replies: /api/v1/posts/{id}/comments/{comment_id}/reply
+	if strings.HasPrefix(r.URL.Path, "/api/v1/posts/") && len(strings.Split(r.URL.Path, "/")) == 8 {
+		parts := strings.Split(r.URL.Path, "/")
+		if parts[5] == "comments" && parts[7] == "reply" && r.Method == "POST" {
+			m.handleReplyToComment(w, r, parts[4], parts[6])
+			return
+		}
+	}
+
 	// Handle user operations
+	if r.URL.Path == "/api/v1/users/me/usage" && r.Method == "GET" {
+		m.handleUsage(w, r)
+		return
+	}
+
 	if r.URL.Path == "/api/v1/users/me" && r.Method == "GET" {
 		m.handleGetMe(w, r)
 		return
@@ -371,17 +862,95 @@ func (m *MockServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.URL.Path == "/api/v1/workspaces/stats" && r.Method == "GET" {
+		m.handleWorkspaceStats(w, r)
+		return
+	}
+
+	// Handle network metadata
+	if r.URL.Path == "/api/v1/networks" && r.Method == "GET" {
+		m.handleListNetworks(w, r)
+		return
+	}
+
+	// Handle plan features
+	if r.URL.Path == "/api/v1/features" && r.Method == "GET" {
+		m.handleFeatures(w, r)
+		return
+	}
+
+	// Handle webhook operations
+	if r.URL.Path == "/api/v1/webhooks" {
+		switch r.Method {
+		case "GET":
+			m.handleListWebhooks(w, r)
+			return
+		case "POST":
+			m.handleCreateWebhook(w, r)
+			return
+		}
+	}
+
+	if strings.HasPrefix(r.URL.Path, "/api/v1/webhooks/") && len(strings.Split(r.URL.Path, "/")) == 5 && r.Method == "DELETE" {
+		m.handleDeleteWebhook(w, r, strings.Split(r.URL.Path, "/")[4])
+		return
+	}
+
+	// Handle template operations
+	if r.URL.Path == "/api/v1/templates" {
+		switch r.Method {
+		case "GET":
+			m.handleListTemplates(w, r)
+			return
+		case "POST":
+			m.handleCreateTemplate(w, r)
+			return
+		}
+	}
+
+	if strings.HasPrefix(r.URL.Path, "/api/v1/templates/") && len(strings.Split(r.URL.Path, "/")) == 5 {
+		templateID := strings.Split(r.URL.Path, "/")[4]
+		switch r.Method {
+		case "GET":
+			m.handleGetTemplate(w, r, templateID)
+			return
+		case "DELETE":
+			m.handleDeleteTemplate(w, r, templateID)
+			return
+		}
+	}
+
+	// Handle media uploads
+	if r.URL.Path == "/api/v1/media" && r.Method == "POST" {
+		m.handleUploadMedia(w, r)
+		return
+	}
+
 	// Handle account operations
 	if r.URL.Path == "/api/v1/accounts" && r.Method == "GET" {
 		m.handleListAccounts(w, r)
 		return
 	}
 
+	// Handle account sub-resource operations: /api/v1/accounts/{id}/{action}
+	if strings.HasPrefix(r.URL.Path, "/api/v1/accounts/") && len(strings.Split(r.URL.Path, "/")) == 6 && r.Method == "GET" {
+		parts := strings.Split(r.URL.Path, "/")
+		switch parts[5] {
+		case "best-times":
+			m.handleBestTimes(w, r, parts[4])
+			return
+		case "reconnect_url":
+			m.handleAccountReconnectURL(w, r, parts[4])
+			return
+		}
+	}
+
 	// Default 404
 	w.WriteHeader(http.StatusNotFound)
 	_ = json.NewEncoder(w).Encode(ErrorResponse{
 		Error:   "not_found",
 		Message: "Endpoint not found",
+		Code:    "endpoint_not_found",
 	})
 }
 
@@ -393,8 +962,11 @@ func (m *MockServer) handleListPosts(w http.ResponseWriter, r *http.Request) {
 		page, _ = strconv.Atoi(pageStr)
 	}
 
-	// Apply filters
+	// Apply filters, then order by ID so pages stay stable if the backing slice is reordered
 	filteredPosts := m.filterPosts(r)
+	sort.SliceStable(filteredPosts, func(i, j int) bool {
+		return filteredPosts[i].ID < filteredPosts[j].ID
+	})
 
 	perPage := defaultPerPage
 	total := len(filteredPosts)
@@ -416,6 +988,12 @@ func (m *MockServer) handleListPosts(w http.ResponseWriter, r *http.Request) {
 		posts = []Post{}
 	}
 
+	if fields := r.URL.Query()["fields[]"]; len(fields) > 0 {
+		for i := range posts {
+			posts[i] = selectPostFields(posts[i], fields)
+		}
+	}
+
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(ListPostsResponse{
 		Posts:      posts,
@@ -426,6 +1004,26 @@ func (m *MockServer) handleListPosts(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// selectPostFields returns a copy of post with only the given JSON fields populated
+func selectPostFields(post Post, fields []string) Post {
+	data, _ := json.Marshal(post)
+
+	var all map[string]json.RawMessage
+	_ = json.Unmarshal(data, &all)
+
+	selected := make(map[string]json.RawMessage, len(fields))
+	for _, field := range fields {
+		if value, ok := all[field]; ok {
+			selected[field] = value
+		}
+	}
+
+	var result Post
+	data, _ = json.Marshal(selected)
+	_ = json.Unmarshal(data, &result)
+	return result
+}
+
 // filterPosts applies query parameter filters to posts
 func (m *MockServer) filterPosts(r *http.Request) []Post {
 	var filtered []Post
@@ -438,8 +1036,12 @@ func (m *MockServer) filterPosts(r *http.Request) []Post {
 	memberID := r.URL.Query().Get("member_id")
 	fromStr := r.URL.Query().Get("from")
 	toStr := r.URL.Query().Get("to")
+	createdFromStr := r.URL.Query().Get("created_from")
+	createdToStr := r.URL.Query().Get("created_to")
+	hasMediaStr := r.URL.Query().Get("has_media")
+	includeTrashed := r.URL.Query().Get("include_trashed") == "true"
 
-	var fromTime, toTime time.Time
+	var fromTime, toTime, createdFrom, createdTo time.Time
 	var err error
 	if fromStr != "" {
 		fromTime, err = time.Parse(time.RFC3339, fromStr)
@@ -453,8 +1055,40 @@ func (m *MockServer) filterPosts(r *http.Request) []Post {
 			toTime = time.Time{}
 		}
 	}
-
-	for _, post := range m.posts {
+	if createdFromStr != "" {
+		createdFrom, err = time.Parse(time.RFC3339, createdFromStr)
+		if err != nil {
+			createdFrom = time.Time{}
+		}
+	}
+	if createdToStr != "" {
+		createdTo, err = time.Parse(time.RFC3339, createdToStr)
+		if err != nil {
+			createdTo = time.Time{}
+		}
+	}
+
+	var hasMedia *bool
+	if hasMediaStr != "" {
+		if v, err := strconv.ParseBool(hasMediaStr); err == nil {
+			hasMedia = &v
+		}
+	}
+
+	candidates := m.posts
+	if includeTrashed {
+		candidates = append([]Post{}, m.posts...)
+		for _, trashed := range m.trashedPosts {
+			candidates = append(candidates, trashed.post)
+		}
+	}
+
+	workspaceID := r.Header.Get("Publer-Workspace-Id")
+	for _, post := range candidates {
+		if !m.inWorkspace(post.ID, workspaceID) {
+			continue
+		}
+
 		// Filter by state (single state)
 		if state != "" && post.State != state {
 			continue
@@ -503,6 +1137,11 @@ func (m *MockServer) filterPosts(r *http.Request) []Post {
 			continue
 		}
 
+		// Filter by media presence
+		if hasMedia != nil && post.HasMedia != *hasMedia {
+			continue
+		}
+
 		// Filter by date range
 		if !fromTime.IsZero() && post.ScheduledAt.Before(fromTime) {
 			continue
@@ -511,6 +1150,14 @@ func (m *MockServer) filterPosts(r *http.Request) []Post {
 			continue
 		}
 
+		// Filter by creation date range
+		if !createdFrom.IsZero() && post.CreatedAt.Before(createdFrom) {
+			continue
+		}
+		if !createdTo.IsZero() && post.CreatedAt.After(createdTo) {
+			continue
+		}
+
 		filtered = append(filtered, post)
 	}
 
@@ -547,6 +1194,45 @@ func (m *MockServer) handlePublishPost(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Handle single post publish
+	var publishReq PublishRequest
+	if err := json.Unmarshal(bodyBytes, &publishReq); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Invalid publish request format",
+		})
+		return
+	}
+
+	if m.strictValidation {
+		if msg := validatePublishFields(publishReq.Text, publishReq.Media, publishReq.Accounts); msg != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "bad_request",
+				Message: msg,
+			})
+			return
+		}
+	}
+
+	if msg := m.validatePostKind(publishReq.PostKind, publishReq.Accounts); msg != "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: msg,
+		})
+		return
+	}
+
+	if msg := m.validateThread(publishReq.Thread, publishReq.Accounts); msg != "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: msg,
+		})
+		return
+	}
+
 	jobID := "job-" + strconv.FormatInt(time.Now().UnixNano(), 36)
 
 	// Set default job status
@@ -556,12 +1242,94 @@ func (m *MockServer) handlePublishPost(w http.ResponseWriter, r *http.Request) {
 		Progress: 0,
 	}
 
+	// Defer the post past the quiet hours of its accounts
+	var deferredTo time.Time
+	if publishReq.RespectQuietHours {
+		now := m.now()
+		if at := m.afterQuietHours(now, publishReq.Accounts); at.After(now) {
+			deferredTo = at
+		}
+	}
+
+	if m.persistPosts {
+		state := "published"
+		if !deferredTo.IsZero() {
+			state = "scheduled"
+		}
+		postIDs := m.createPosts(Post{
+			Text:        publishReq.Text,
+			State:       state,
+			ScheduledAt: deferredTo,
+			HasMedia:    len(publishReq.Media) > 0,
+			Media:       publishReq.Media,
+			URL:         publishReq.Link,
+			PostLink:    publishReq.Link,
+			PostKind:    publishReq.PostKind,
+			Thread:      publishReq.Thread,
+			Poll:        publishReq.Poll,
+			Labels:      publishReq.Labels,
+			ExpiresAt:   publishReq.ExpiresAt,
+		}, publishReq.Accounts, publishReq.Variants)
+		m.completeJob(jobID, postIDs)
+	}
+
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(PublishResponse{
-		JobID: jobID,
+		JobID:       jobID,
+		ScheduledAt: deferredTo,
 	})
 }
 
+// validatePostKind returns a validation message when the post kind is unknown or
+// not supported by the network of any of the target accounts
+func (m *MockServer) validatePostKind(kind string, accounts []string) string {
+	if kind == "" {
+		return ""
+	}
+	if err := validatePostKind(kind); err != nil {
+		return fmt.Sprintf("Invalid post kind: %s", kind)
+	}
+
+	for _, accountID := range accounts {
+		for _, account := range m.accounts {
+			if account.ID != accountID {
+				continue
+			}
+			if !networkSupportsPostKind(account.Provider, kind) {
+				return fmt.Sprintf("Post kind %s is not supported for %s", kind, account.Provider)
+			}
+		}
+	}
+	return ""
+}
+
+// validateThread returns a validation message when a thread targets a single network
+// that does not support threads. Mixed-network requests post only Text where threads
+// are unsupported.
+func (m *MockServer) validateThread(thread []string, accounts []string) string {
+	if len(thread) == 0 {
+		return ""
+	}
+
+	networks := make(map[string]bool)
+	for _, accountID := range accounts {
+		for _, account := range m.accounts {
+			if account.ID == accountID {
+				networks[account.Provider] = true
+			}
+		}
+	}
+	if len(networks) != 1 {
+		return ""
+	}
+	for network := range networks {
+		if !networkSupportsThreads(network) {
+			return fmt.Sprintf("Threads are not supported for %s", network)
+		}
+	}
+	return ""
+}
+
 // handleBulkPublish handles bulk publishing requests
 func (m *MockServer) handleBulkPublish(w http.ResponseWriter, r *http.Request, bodyBytes []byte, postsData interface{}) {
 	var bulkReq BulkPublishRequest
@@ -584,6 +1352,19 @@ func (m *MockServer) handleBulkPublish(w http.ResponseWriter, r *http.Request, b
 		return
 	}
 
+	if m.strictValidation {
+		for i, post := range bulkReq.Posts {
+			if msg := validatePublishFields(post.Text, post.Media, post.Accounts); msg != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(ErrorResponse{
+					Error:   "bad_request",
+					Message: fmt.Sprintf("Post %d: %s", i+1, msg),
+				})
+				return
+			}
+		}
+	}
+
 	jobID := "job-" + strconv.FormatInt(time.Now().UnixNano(), 36)
 
 	// Set default job status
@@ -599,6 +1380,56 @@ func (m *MockServer) handleBulkPublish(w http.ResponseWriter, r *http.Request, b
 	})
 }
 
+// validatePublishFields returns a validation message when required publish fields are missing
+func validatePublishFields(text string, media []Media, accounts []string) string {
+	if text == "" && len(media) == 0 {
+		return "Text or media is required"
+	}
+	if len(accounts) == 0 {
+		return "At least one account is required"
+	}
+	return ""
+}
+
+// jobStatus returns the current status of a job, preferring a configured progression
+func (m *MockServer) jobStatus(jobID string) (JobStatus, bool) {
+	if states, exists := m.jobProgression[jobID]; exists {
+		index := m.jobProgressIndex[jobID]
+		if index < len(states) {
+			return states[index], true
+		}
+	}
+
+	if job, exists := m.jobs[jobID]; exists {
+		return *job, true
+	}
+	return JobStatus{}, false
+}
+
+// handleJobStatuses handles GET /api/v1/job_status?ids[]=... returning the status of
+// every known job
+func (m *MockServer) handleJobStatuses(w http.ResponseWriter, r *http.Request) {
+	ids := r.URL.Query()["ids[]"]
+	if len(ids) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "At least one job ID is required",
+		})
+		return
+	}
+
+	jobs := make(map[string]JobStatus, len(ids))
+	for _, id := range ids {
+		if status, exists := m.jobStatus(id); exists {
+			jobs[id] = status
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(GetJobStatusesResponse{Jobs: jobs})
+}
+
 // handleJobStatus handles GET /api/v1/job_status/{job_id}
 func (m *MockServer) handleJobStatus(w http.ResponseWriter, r *http.Request) {
 	parts := strings.Split(r.URL.Path, "/")
@@ -613,23 +1444,10 @@ func (m *MockServer) handleJobStatus(w http.ResponseWriter, r *http.Request) {
 
 	jobID := parts[4]
 
-	// Check job progression first
-	if states, exists := m.jobProgression[jobID]; exists {
-		index := m.jobProgressIndex[jobID]
-		if index < len(states) {
-			w.WriteHeader(http.StatusOK)
-			_ = json.NewEncoder(w).Encode(GetJobStatusResponse{
-				JobStatus: states[index],
-			})
-			return
-		}
-	}
-
-	// Check regular job status
-	if job, exists := m.jobs[jobID]; exists {
+	if status, exists := m.jobStatus(jobID); exists {
 		w.WriteHeader(http.StatusOK)
 		_ = json.NewEncoder(w).Encode(GetJobStatusResponse{
-			JobStatus: *job,
+			JobStatus: status,
 		})
 		return
 	}
@@ -638,6 +1456,7 @@ func (m *MockServer) handleJobStatus(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(ErrorResponse{
 		Error:   "not_found",
 		Message: "Job not found",
+		Code:    "job_not_found",
 	})
 }
 
@@ -703,6 +1522,16 @@ func (m *MockServer) handleSchedulePost(w http.ResponseWriter, r *http.Request)
 			return
 		}
 
+		if m.persistPosts {
+			postIDs := m.createPosts(Post{
+				Text:     draftReq.Text,
+				State:    "draft",
+				HasMedia: len(draftReq.Media) > 0,
+				Media:    draftReq.Media,
+			}, draftReq.Accounts, nil)
+			m.completeJob(jobID, postIDs)
+		}
+
 		w.WriteHeader(http.StatusOK)
 		_ = json.NewEncoder(w).Encode(CreateDraftResponse{
 			JobID: jobID,
@@ -720,8 +1549,17 @@ func (m *MockServer) handleSchedulePost(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// Resolve "auto" to the next optimal slot, which the mock fixes at the next full hour
+	if scheduleReq.Auto {
+		scheduleReq.ScheduledAt = m.now().Truncate(time.Hour).Add(time.Hour)
+	}
+
+	if scheduleReq.RespectQuietHours {
+		scheduleReq.ScheduledAt = m.afterQuietHours(scheduleReq.ScheduledAt, scheduleReq.Accounts)
+	}
+
 	// Validate that scheduled_at is in the future
-	if !scheduleReq.ScheduledAt.After(time.Now()) {
+	if !scheduleReq.ScheduledAt.After(m.now()) {
 		w.WriteHeader(http.StatusBadRequest)
 		_ = json.NewEncoder(w).Encode(ErrorResponse{
 			Error:   "bad_request",
@@ -730,9 +1568,61 @@ func (m *MockServer) handleSchedulePost(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if msg := m.validatePostKind(scheduleReq.PostKind, scheduleReq.Accounts); msg != "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: msg,
+		})
+		return
+	}
+
+	if msg := m.validateThread(scheduleReq.Thread, scheduleReq.Accounts); msg != "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: msg,
+		})
+		return
+	}
+
+	// Resolve the scheduled time in the requested time zone, defaulting to UTC
+	timeZone := scheduleReq.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: fmt.Sprintf("Invalid time zone: %s", scheduleReq.TimeZone),
+		})
+		return
+	}
+
+	if m.persistPosts {
+		postIDs := m.createPosts(Post{
+			Text:        scheduleReq.Text,
+			State:       "scheduled",
+			ScheduledAt: scheduleReq.ScheduledAt,
+			HasMedia:    len(scheduleReq.Media) > 0,
+			Media:       scheduleReq.Media,
+			URL:         scheduleReq.Link,
+			PostLink:    scheduleReq.Link,
+			PostKind:    scheduleReq.PostKind,
+			Thread:      scheduleReq.Thread,
+			Poll:        scheduleReq.Poll,
+			ExpiresAt:   scheduleReq.ExpiresAt,
+		}, scheduleReq.Accounts, scheduleReq.Variants)
+		m.completeJob(jobID, postIDs)
+	}
+
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(ScheduleResponse{
-		JobID: jobID,
+		JobID:        jobID,
+		ResolvedTime: scheduleReq.ScheduledAt.In(location),
+		TimeZone:     timeZone,
 	})
 }
 
@@ -760,7 +1650,15 @@ func (m *MockServer) handleBulkSchedule(w http.ResponseWriter, r *http.Request,
 
 	// Validate that all scheduled posts have future timestamps
 	for i, post := range bulkReq.Posts {
-		if !post.ScheduledAt.IsZero() && !post.ScheduledAt.After(time.Now()) {
+		if post.PublishNow && !post.ScheduledAt.IsZero() {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "bad_request",
+				Message: fmt.Sprintf("Post %d: Scheduled time cannot be combined with publish now", i+1),
+			})
+			return
+		}
+		if !post.ScheduledAt.IsZero() && !post.ScheduledAt.After(m.now()) {
 			w.WriteHeader(http.StatusBadRequest)
 			_ = json.NewEncoder(w).Encode(ErrorResponse{
 				Error:   "bad_request",
@@ -779,9 +1677,33 @@ func (m *MockServer) handleBulkSchedule(w http.ResponseWriter, r *http.Request,
 		Progress: 0,
 	}
 
+	// Publish immediate posts and schedule the rest
+	items := make([]BulkItemResult, len(bulkReq.Posts))
+	var allPostIDs []string
+	for i, post := range bulkReq.Posts {
+		items[i] = BulkItemResult{Index: i, State: PostStateScheduled}
+		if post.PublishNow {
+			items[i].State = "published"
+		}
+		if m.persistPosts {
+			items[i].PostIDs = m.createPosts(Post{
+				Text:        post.Text,
+				State:       items[i].State,
+				ScheduledAt: post.ScheduledAt,
+				HasMedia:    len(post.Media) > 0,
+				Media:       post.Media,
+			}, post.Accounts, nil)
+			allPostIDs = append(allPostIDs, items[i].PostIDs...)
+		}
+	}
+	if m.persistPosts {
+		m.completeJob(jobID, allPostIDs)
+	}
+
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(BulkScheduleResponse{
 		JobID: jobID,
+		Items: items,
 	})
 }
 
@@ -797,16 +1719,27 @@ func (m *MockServer) handleGetMe(w http.ResponseWriter, r *http.Request) {
 		_ = json.NewEncoder(w).Encode(ErrorResponse{
 			Error:   "not_found",
 			Message: "User not found",
+			Code:    "user_not_found",
 		})
 		return
 	}
 
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(GetMeResponse{
-		User: *m.currentUser,
+		User:         *m.currentUser,
+		AccountLimit: m.accountLimit,
 	})
 }
 
+// handleUsage handles GET /api/v1/users/me/usage
+func (m *MockServer) handleUsage(w http.ResponseWriter, r *http.Request) {
+	usage := m.dailyUsage
+	usage.BulkPostsLimit = m.bulkOpLimit
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(usage)
+}
+
 // handleListWorkspaces handles GET /api/v1/workspaces
 func (m *MockServer) handleListWorkspaces(w http.ResponseWriter, r *http.Request) {
 	pageStr := r.URL.Query().Get("page")
@@ -842,16 +1775,15 @@ func (m *MockServer) handleListWorkspaces(w http.ResponseWriter, r *http.Request
 	})
 }
 
-// handleListAccounts handles GET /api/v1/accounts
-func (m *MockServer) handleListAccounts(w http.ResponseWriter, r *http.Request) {
-	pageStr := r.URL.Query().Get("page")
+// handleListWebhooks handles GET /api/v1/webhooks
+func (m *MockServer) handleListWebhooks(w http.ResponseWriter, r *http.Request) {
 	page := 1
-	if pageStr != "" {
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
 		page, _ = strconv.Atoi(pageStr)
 	}
 
 	perPage := defaultPerPage
-	total := len(m.accounts)
+	total := len(m.webhooks)
 	totalPages := (total + perPage - 1) / perPage
 
 	start := (page - 1) * perPage
@@ -860,16 +1792,14 @@ func (m *MockServer) handleListAccounts(w http.ResponseWriter, r *http.Request)
 		end = total
 	}
 
-	var accounts []Account
+	webhooks := []Webhook{}
 	if start < total {
-		accounts = m.accounts[start:end]
-	} else {
-		accounts = []Account{}
+		webhooks = m.webhooks[start:end]
 	}
 
 	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(ListAccountsResponse{
-		Accounts:   accounts,
+	_ = json.NewEncoder(w).Encode(ListWebhooksResponse{
+		Webhooks:   webhooks,
 		Total:      total,
 		Page:       page,
 		PerPage:    perPage,
@@ -877,83 +1807,618 @@ func (m *MockServer) handleListAccounts(w http.ResponseWriter, r *http.Request)
 	})
 }
 
-// AddAccount adds a social media account to mock data
-func (m *MockServer) AddAccount(account Account) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
-	m.accounts = append(m.accounts, account)
-}
-
-// SetAccountsByProvider sets accounts filtered by provider
-func (m *MockServer) SetAccountsByProvider(provider string, accounts []Account) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
-	// Clear existing accounts for this provider and add new ones
-	var filteredAccounts []Account
-	for _, acc := range m.accounts {
-		if acc.Provider != provider {
-			filteredAccounts = append(filteredAccounts, acc)
-		}
+// handleCreateWebhook handles POST /api/v1/webhooks
+func (m *MockServer) handleCreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var req CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Invalid JSON payload",
+		})
+		return
 	}
 
-	m.accounts = append(filteredAccounts, accounts...)
-}
+	if !strings.HasPrefix(req.URL, "https://") || len(req.Events) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "An https URL and at least one event are required",
+		})
+		return
+	}
 
-// SetBulkOperationLimit sets maximum posts per bulk operation
-func (m *MockServer) SetBulkOperationLimit(limit int) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+	id := strconv.FormatInt(time.Now().UnixNano(), 36)
+	webhook := Webhook{
+		ID:     "webhook-" + id,
+		URL:    req.URL,
+		Events: req.Events,
+		Secret: "whsec_" + id,
+	}
+	m.webhooks = append(m.webhooks, webhook)
 
-	m.bulkOpLimit = limit
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(CreateWebhookResponse{Webhook: webhook})
 }
 
-// handleGetPost handles GET /api/v1/posts/{id}
-func (m *MockServer) handleGetPost(w http.ResponseWriter, r *http.Request, postID string) {
-	// Find post by ID
-	for _, post := range m.posts {
-		if post.ID == postID {
-			w.Header().Set("Content-Type", "application/json")
-			_ = json.NewEncoder(w).Encode(GetPostResponse{Post: post})
+// handleDeleteWebhook handles DELETE /api/v1/webhooks/{id}
+func (m *MockServer) handleDeleteWebhook(w http.ResponseWriter, r *http.Request, webhookID string) {
+	for i, webhook := range m.webhooks {
+		if webhook.ID == webhookID {
+			m.webhooks = append(m.webhooks[:i], m.webhooks[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
 			return
 		}
 	}
 
-	// Post not found
 	w.WriteHeader(http.StatusNotFound)
 	_ = json.NewEncoder(w).Encode(ErrorResponse{
 		Error:   "not_found",
-		Message: "Post not found",
+		Message: "Webhook not found",
+		Code:    "webhook_not_found",
 	})
 }
 
-// handleUpdatePost handles PATCH /api/v1/posts/{id}
-func (m *MockServer) handleUpdatePost(w http.ResponseWriter, r *http.Request, postID string) {
-	// Read request body
-	bodyBytes, err := io.ReadAll(r.Body)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		_ = json.NewEncoder(w).Encode(ErrorResponse{
-			Error:   "bad_request",
-			Message: "Failed to read request body",
-		})
-		return
+// handleListTemplates handles GET /api/v1/templates
+func (m *MockServer) handleListTemplates(w http.ResponseWriter, r *http.Request) {
+	page := 1
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		page, _ = strconv.Atoi(pageStr)
 	}
 
-	var updateReq UpdatePostRequest
-	if err := json.Unmarshal(bodyBytes, &updateReq); err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		_ = json.NewEncoder(w).Encode(ErrorResponse{
-			Error:   "bad_request",
-			Message: "Invalid JSON in request body",
-		})
-		return
-	}
+	perPage := defaultPerPage
+	total := len(m.templates)
+	totalPages := (total + perPage - 1) / perPage
+
+	start := (page - 1) * perPage
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+
+	templates := []Template{}
+	if start < total {
+		templates = m.templates[start:end]
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(ListTemplatesResponse{
+		Templates:  templates,
+		Total:      total,
+		Page:       page,
+		PerPage:    perPage,
+		TotalPages: totalPages,
+	})
+}
+
+// handleCreateTemplate handles POST /api/v1/templates
+func (m *MockServer) handleCreateTemplate(w http.ResponseWriter, r *http.Request) {
+	var req CreateTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if req.Name == "" || req.Text == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Template name and text are required",
+		})
+		return
+	}
+
+	template := Template{
+		ID:     "template-" + strconv.FormatInt(time.Now().UnixNano(), 36),
+		Name:   req.Name,
+		Text:   req.Text,
+		Media:  req.Media,
+		Labels: req.Labels,
+	}
+	m.templates = append(m.templates, template)
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(CreateTemplateResponse{Template: template})
+}
+
+// handleGetTemplate handles GET /api/v1/templates/{id}
+func (m *MockServer) handleGetTemplate(w http.ResponseWriter, r *http.Request, templateID string) {
+	for _, template := range m.templates {
+		if template.ID == templateID {
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(GetTemplateResponse{Template: template})
+			return
+		}
+	}
+	m.writeTemplateNotFound(w)
+}
+
+// handleDeleteTemplate handles DELETE /api/v1/templates/{id}
+func (m *MockServer) handleDeleteTemplate(w http.ResponseWriter, r *http.Request, templateID string) {
+	for i, template := range m.templates {
+		if template.ID == templateID {
+			m.templates = append(m.templates[:i], m.templates[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	m.writeTemplateNotFound(w)
+}
+
+// writeTemplateNotFound writes the 404 response for an unknown template
+func (m *MockServer) writeTemplateNotFound(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   "not_found",
+		Message: "Template not found",
+		Code:    "template_not_found",
+	})
+}
+
+// handleWorkspaceStats handles GET /api/v1/workspaces/stats
+func (m *MockServer) handleWorkspaceStats(w http.ResponseWriter, r *http.Request) {
+	stats := WorkspaceStats{
+		Total:     len(m.posts),
+		ByState:   make(map[string]int),
+		ByNetwork: make(map[string]int),
+	}
+
+	for _, post := range m.posts {
+		stats.ByState[post.State]++
+		stats.ByNetwork[post.Network]++
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(stats)
+}
+
+// handleListNetworks handles GET /api/v1/networks
+func (m *MockServer) handleListNetworks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(ListNetworksResponse{
+		Networks: []NetworkInfo{
+			{ID: "facebook", Name: "Facebook", CharLimit: 63206, MediaTypes: []string{"image", "video"}, MaxMedia: 10},
+			{ID: "instagram", Name: "Instagram", CharLimit: 2200, MediaTypes: []string{"image", "video"}, MaxMedia: 10},
+			{ID: "linkedin", Name: "LinkedIn", CharLimit: 3000, MediaTypes: []string{"image", "video", "document"}, MaxMedia: 9},
+			{ID: "twitter", Name: "Twitter", CharLimit: 280, MediaTypes: []string{"image", "video", "gif"}, MaxMedia: 4},
+		},
+	})
+}
+
+// handleFeatures handles GET /api/v1/features
+func (m *MockServer) handleFeatures(w http.ResponseWriter, r *http.Request) {
+	features := m.features
+	if features == nil {
+		features = map[string]bool{}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(FeaturesResponse{Features: features})
+}
+
+// handleUploadMedia handles POST /api/v1/media
+func (m *MockServer) handleUploadMedia(w http.ResponseWriter, r *http.Request) {
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Missing file in multipart form",
+		})
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	size, err := io.Copy(io.Discard, file)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Failed to read uploaded file",
+		})
+		return
+	}
+
+	if m.maxUploadBytes > 0 && size > m.maxUploadBytes {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "payload_too_large",
+			Message: fmt.Sprintf("File %s is %d bytes, exceeding the limit of %d bytes", header.Filename, size, m.maxUploadBytes),
+		})
+		return
+	}
+
+	m.uploads = append(m.uploads, MockUpload{Filename: header.Filename, Size: size})
+	id := fmt.Sprintf("media-%d", len(m.uploads))
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(UploadMediaResponse{
+		ID:       id,
+		URL:      fmt.Sprintf("https://cdn.publer.mock/media/%s/%s", id, header.Filename),
+		Filename: header.Filename,
+		Size:     size,
+	})
+}
+
+// handleListAccounts handles GET /api/v1/accounts
+func (m *MockServer) handleListAccounts(w http.ResponseWriter, r *http.Request) {
+	pageStr := r.URL.Query().Get("page")
+	page := 1
+	if pageStr != "" {
+		page, _ = strconv.Atoi(pageStr)
+	}
+
+	// Order by ID so pages stay stable if the backing slice is reordered
+	sorted := append([]Account{}, m.accounts...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	perPage := defaultPerPage
+	total := len(sorted)
+	totalPages := (total + perPage - 1) / perPage
+
+	start := (page - 1) * perPage
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+
+	var accounts []Account
+	if start < total {
+		accounts = sorted[start:end]
+	} else {
+		accounts = []Account{}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(ListAccountsResponse{
+		Accounts:   accounts,
+		Total:      total,
+		Page:       page,
+		PerPage:    perPage,
+		TotalPages: totalPages,
+	})
+}
+
+// AddAccount adds a social media account to mock data
+func (m *MockServer) AddAccount(account Account) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.accounts = append(m.accounts, account)
+}
+
+// SetAccountPermissions sets the permissions the current user holds on an account
+func (m *MockServer) SetAccountPermissions(accountID string, permissions []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.accounts {
+		if m.accounts[i].ID == accountID {
+			m.accounts[i].Permissions = permissions
+			return
+		}
+	}
+}
+
+// SetAccountState sets the connection state of an account, e.g. AccountStateExpired
+func (m *MockServer) SetAccountState(accountID, state string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.accounts {
+		if m.accounts[i].ID == accountID {
+			m.accounts[i].State = state
+			return
+		}
+	}
+}
+
+// SetAccountsByProvider sets accounts filtered by provider
+func (m *MockServer) SetAccountsByProvider(provider string, accounts []Account) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Clear existing accounts for this provider and add new ones
+	var filteredAccounts []Account
+	for _, acc := range m.accounts {
+		if acc.Provider != provider {
+			filteredAccounts = append(filteredAccounts, acc)
+		}
+	}
+
+	m.accounts = append(filteredAccounts, accounts...)
+}
+
+// SetBulkOperationLimit sets maximum posts per bulk operation
+func (m *MockServer) SetBulkOperationLimit(limit int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.bulkOpLimit = limit
+}
+
+// handleGetPost handles GET /api/v1/posts/{id}
+func (m *MockServer) handleGetPost(w http.ResponseWriter, r *http.Request, postID string) {
+	// Find post by ID
+	for _, post := range m.posts {
+		if post.ID == postID && m.inWorkspace(postID, r.Header.Get("Publer-Workspace-Id")) {
+			body, _ := json.Marshal(GetPostResponse{Post: post})
+			sum := sha256.Sum256(body)
+			etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+
+			w.Header().Set("ETag", etag)
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+			return
+		}
+	}
+
+	// Post not found
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   "not_found",
+		Message: "Post not found",
+		Code:    "post_not_found",
+	})
+}
+
+// handleBestTimes handles GET /api/v1/accounts/{id}/best-times
+func (m *MockServer) handleBestTimes(w http.ResponseWriter, r *http.Request, accountID string) {
+	slots, found := m.bestTimes[accountID]
+	for _, account := range m.accounts {
+		if account.ID == accountID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "not_found",
+			Message: "Account not found",
+			Code:    "account_not_found",
+		})
+		return
+	}
+
+	if slots == nil {
+		slots = []TimeSlot{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(BestTimesResponse{Slots: slots})
+}
+
+// handleAccountReconnectURL handles GET /api/v1/accounts/{id}/reconnect_url. Accounts
+// without a state are treated as active and cannot be reconnected.
+func (m *MockServer) handleAccountReconnectURL(w http.ResponseWriter, r *http.Request, accountID string) {
+	for _, account := range m.accounts {
+		if account.ID != accountID {
+			continue
+		}
+		if account.State == "" || account.State == AccountStateActive {
+			w.WriteHeader(http.StatusConflict)
+			_ = json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "conflict",
+				Message: "Account is already connected",
+				Code:    "account_active",
+			})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(AccountReconnectURLResponse{
+			URL: fmt.Sprintf("https://app.publer.com/accounts/%s/reconnect", accountID),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   "not_found",
+		Message: "Account not found",
+		Code:    "account_not_found",
+	})
+}
+
+// handlePostAnalytics handles GET /api/v1/posts/{id}/analytics. Only published posts
+// have analytics; posts without seeded metrics report zeros.
+func (m *MockServer) handlePostAnalytics(w http.ResponseWriter, r *http.Request, postID string) {
+	for _, post := range m.posts {
+		if post.ID != postID {
+			continue
+		}
+		if post.State != PostStatePublished {
+			w.WriteHeader(http.StatusConflict)
+			_ = json.NewEncoder(w).Encode(ErrorResponse{
+				Error:   "conflict",
+				Message: "Analytics are only available for published posts",
+				Code:    "post_not_published",
+			})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(m.analytics[postID])
+		return
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   "not_found",
+		Message: "Post not found",
+		Code:    "post_not_found",
+	})
+}
+
+// handlePostComments handles GET /api/v1/posts/{id}/comments
+func (m *MockServer) handlePostComments(w http.ResponseWriter, r *http.Request, postID string) {
+	comments, found := m.comments[postID]
+	for _, post := range m.posts {
+		if post.ID == postID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "not_found",
+			Message: "Post not found",
+			Code:    "post_not_found",
+		})
+		return
+	}
+
+	page := 1
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		page, _ = strconv.Atoi(pageStr)
+	}
+
+	perPage := defaultPerPage
+	total := len(comments)
+	totalPages := (total + perPage - 1) / perPage
+
+	start := (page - 1) * perPage
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+
+	pageComments := []Comment{}
+	if start < total {
+		pageComments = comments[start:end]
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(GetPostCommentsResponse{
+		Comments:   pageComments,
+		Total:      total,
+		Page:       page,
+		PerPage:    perPage,
+		TotalPages: totalPages,
+	})
+}
+
+// handleReplyToComment handles POST /api/v1/posts/{id}/comments/{comment_id}/reply,
+// appending the reply to the post's comments
+func (m *MockServer) handleReplyToComment(w http.ResponseWriter, r *http.Request, postID, commentID string) {
+	var req ReplyToCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if strings.TrimSpace(req.Text) == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Reply text is required",
+		})
+		return
+	}
+
+	comments := m.comments[postID]
+	var found bool
+	for _, comment := range comments {
+		if comment.ID == commentID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "not_found",
+			Message: "Comment not found",
+			Code:    "comment_not_found",
+		})
+		return
+	}
+
+	reply := Comment{
+		ID:        fmt.Sprintf("reply-%d", len(comments)+1),
+		Text:      req.Text,
+		CreatedAt: m.now(),
+		ParentID:  commentID,
+	}
+	if m.currentUser != nil {
+		reply.Author = m.currentUser.Name
+	}
+	m.comments[postID] = append(comments, reply)
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(ReplyToCommentResponse{Comment: reply})
+}
+
+// handleUpdatePost handles PATCH /api/v1/posts/{id}
+func (m *MockServer) handleUpdatePost(w http.ResponseWriter, r *http.Request, postID string) {
+	// Read request body
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Failed to read request body",
+		})
+		return
+	}
+
+	var updateReq UpdatePostRequest
+	if err := json.Unmarshal(bodyBytes, &updateReq); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Invalid JSON in request body",
+		})
+		return
+	}
+
+	// Find and update post
+	for i, post := range m.posts {
+		if post.ID == postID {
+			if updateReq.Accounts != nil && len(updateReq.Accounts) != 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(ErrorResponse{
+					Error:   "bad_request",
+					Message: "A post must target exactly one account",
+				})
+				return
+			}
+			if updateReq.State != "" {
+				if updateReq.State != PostStateScheduled {
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(ErrorResponse{
+						Error:   "bad_request",
+						Message: fmt.Sprintf("Unsupported state change to %s", updateReq.State),
+					})
+					return
+				}
+				if post.State != PostStateDraft {
+					w.WriteHeader(http.StatusConflict)
+					_ = json.NewEncoder(w).Encode(ErrorResponse{
+						Error:   "conflict",
+						Message: fmt.Sprintf("Only drafts can be scheduled, post is %s", post.State),
+					})
+					return
+				}
+				m.posts[i].State = updateReq.State
+			}
 
-	// Find and update post
-	for i, post := range m.posts {
-		if post.ID == postID {
 			// Apply partial updates
 			if updateReq.Text != "" {
 				m.posts[i].Text = updateReq.Text
@@ -962,8 +2427,18 @@ func (m *MockServer) handleUpdatePost(w http.ResponseWriter, r *http.Request, po
 				m.posts[i].ScheduledAt = updateReq.ScheduledAt
 			}
 			if updateReq.Media != nil {
+				m.posts[i].Media = updateReq.Media
 				m.posts[i].HasMedia = len(updateReq.Media) > 0
 			}
+			if updateReq.Accounts != nil {
+				m.posts[i].AccountID = updateReq.Accounts[0]
+				for _, account := range m.accounts {
+					if account.ID == updateReq.Accounts[0] {
+						m.posts[i].Network = account.Provider
+						break
+					}
+				}
+			}
 
 			w.Header().Set("Content-Type", "application/json")
 			_ = json.NewEncoder(w).Encode(UpdatePostResponse{Post: m.posts[i]})
@@ -976,6 +2451,7 @@ func (m *MockServer) handleUpdatePost(w http.ResponseWriter, r *http.Request, po
 	_ = json.NewEncoder(w).Encode(ErrorResponse{
 		Error:   "not_found",
 		Message: "Post not found",
+		Code:    "post_not_found",
 	})
 }
 
@@ -996,10 +2472,17 @@ func (m *MockServer) handleDeletePost(w http.ResponseWriter, r *http.Request, po
 		_ = json.NewEncoder(w).Encode(ErrorResponse{
 			Error:   "not_found",
 			Message: "Post not found",
+			Code:    "post_not_found",
 		})
 		return
 	}
 
+	// Keep the post in the trash so it can be listed or restored later
+	trashed := m.posts[foundIndex]
+	previousState := trashed.State
+	trashed.State = PostStateTrashed
+	m.trashedPosts = append(m.trashedPosts, trashedPost{post: trashed, previousState: previousState})
+
 	// Remove post from slice safely
 	if foundIndex == len(m.posts)-1 {
 		// Last element - just truncate
@@ -1017,6 +2500,199 @@ func (m *MockServer) handleDeletePost(w http.ResponseWriter, r *http.Request, po
 	})
 }
 
+// duplicateWindow is how far back the mock looks for identical content
+const duplicateWindow = 24 * time.Hour
+
+// handleDuplicateCheck handles POST /api/v1/posts/duplicate_check, flagging text identical
+// to a post created for the same account within duplicateWindow
+func (m *MockServer) handleDuplicateCheck(w http.ResponseWriter, r *http.Request) {
+	var req DuplicateCheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if req.AccountID == "" || strings.TrimSpace(req.Text) == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Account ID and text are required",
+		})
+		return
+	}
+
+	since := m.now().Add(-duplicateWindow)
+	var duplicate bool
+	for _, post := range m.posts {
+		if post.AccountID != req.AccountID || post.CreatedAt.Before(since) {
+			continue
+		}
+		if strings.TrimSpace(post.Text) == strings.TrimSpace(req.Text) {
+			duplicate = true
+			break
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(DuplicateCheckResponse{Duplicate: duplicate})
+}
+
+// handleUpdateLabels handles PATCH /api/v1/posts/labels
+func (m *MockServer) handleUpdateLabels(w http.ResponseWriter, r *http.Request) {
+	var req UpdateLabelsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if req.Action != "add" && req.Action != "remove" {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Invalid action. Must be add or remove",
+		})
+		return
+	}
+
+	// Resolve every post ID before changing anything so the update is all-or-nothing
+	indexes := make([]int, 0, len(req.PostIDs))
+	var unknown []string
+	for _, postID := range req.PostIDs {
+		found := -1
+		for i, post := range m.posts {
+			if post.ID == postID {
+				found = i
+				break
+			}
+		}
+		if found == -1 {
+			unknown = append(unknown, postID)
+			continue
+		}
+		indexes = append(indexes, found)
+	}
+
+	if len(unknown) > 0 {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "not_found",
+			Message: fmt.Sprintf("Unknown post IDs: %s", strings.Join(unknown, ", ")),
+			Code:    "post_not_found",
+		})
+		return
+	}
+
+	for _, i := range indexes {
+		for _, label := range req.Labels {
+			has := false
+			for j, existing := range m.posts[i].Labels {
+				if existing == label {
+					has = true
+					if req.Action == "remove" {
+						m.posts[i].Labels = append(m.posts[i].Labels[:j], m.posts[i].Labels[j+1:]...)
+					}
+					break
+				}
+			}
+			if !has && req.Action == "add" {
+				m.posts[i].Labels = append(m.posts[i].Labels, label)
+			}
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRestorePost handles POST /api/v1/posts/{id}/restore
+func (m *MockServer) handleRestorePost(w http.ResponseWriter, r *http.Request, postID string) {
+	for i, trashed := range m.trashedPosts {
+		if trashed.post.ID == postID {
+			post := trashed.post
+			post.State = trashed.previousState
+			m.posts = append(m.posts, post)
+			m.trashedPosts = append(m.trashedPosts[:i], m.trashedPosts[i+1:]...)
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(GetPostResponse{Post: post})
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   "not_found",
+		Message: "Post not found in trash",
+		Code:    "post_not_found",
+	})
+}
+
+// handleMovePostToWorkspace handles POST /api/v1/posts/{id}/move-workspace, moving a
+// post from the request's workspace to the target workspace
+func (m *MockServer) handleMovePostToWorkspace(w http.ResponseWriter, r *http.Request, postID string) {
+	var req MovePostToWorkspaceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "bad_request",
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if !m.knownWorkspace(req.WorkspaceID) {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "not_found",
+			Message: "Workspace not found",
+			Code:    "workspace_not_found",
+		})
+		return
+	}
+
+	for _, post := range m.posts {
+		if post.ID == postID && m.inWorkspace(postID, r.Header.Get("Publer-Workspace-Id")) {
+			m.postWorkspaces[postID] = req.WorkspaceID
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   "not_found",
+		Message: "Post not found",
+		Code:    "post_not_found",
+	})
+}
+
+// handlePinPost pins or unpins a stored post
+func (m *MockServer) handlePinPost(w http.ResponseWriter, r *http.Request, postID string, pinned bool) {
+	for i := range m.posts {
+		if m.posts[i].ID == postID {
+			m.posts[i].Pinned = pinned
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(GetPostResponse{Post: m.posts[i]})
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   "not_found",
+		Message: "Post not found",
+		Code:    "post_not_found",
+	})
+}
+
 // UpdateMockPost updates a post in mock data
 func (m *MockServer) UpdateMockPost(id string, updates map[string]any) {
 	m.mu.Lock()
@@ -1090,9 +2766,12 @@ func (m *MockServer) handleRecurringPost(w http.ResponseWriter, r *http.Request)
 	}
 
 	jobID := fmt.Sprintf("recurring-%d", time.Now().UnixNano())
+	seriesID := fmt.Sprintf("series-%d", time.Now().UnixNano())
+	m.series[seriesID] = mockSeries{request: req, start: m.now()}
 
 	response := RecurringPostResponse{
-		JobID: jobID,
+		JobID:    jobID,
+		SeriesID: seriesID,
 	}
 
 	m.jobs[jobID] = &JobStatus{
@@ -1105,6 +2784,51 @@ func (m *MockServer) handleRecurringPost(w http.ResponseWriter, r *http.Request)
 	_ = json.NewEncoder(w).Encode(response)
 }
 
+// handleGetSeries handles GET /api/v1/posts/recurring/{id}, generating the
+// occurrences of the series from its recurrence rule
+func (m *MockServer) handleGetSeries(w http.ResponseWriter, r *http.Request, seriesID string) {
+	series, exists := m.series[seriesID]
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{
+			Error:   "not_found",
+			Message: "Series not found",
+			Code:    "series_not_found",
+		})
+		return
+	}
+
+	rule := series.request.Recurrence
+	now := m.now()
+	response := Series{ID: seriesID, Status: SeriesStatusActive, Occurrences: []Post{}}
+	for i, scheduledAt := range rule.NextOccurrences(series.start, maxMockSeriesOccurrences) {
+		state := PostStateScheduled
+		if !scheduledAt.After(now) {
+			state = PostStatePublished
+		}
+		response.Occurrences = append(response.Occurrences, Post{
+			ID:          fmt.Sprintf("%s-%d", seriesID, i+1),
+			Text:        series.request.Text,
+			State:       state,
+			ScheduledAt: scheduledAt,
+			CreatedAt:   series.start,
+			HasMedia:    len(series.request.Media) > 0,
+			Media:       series.request.Media,
+		})
+	}
+
+	// A bounded series completes once its last occurrence has passed
+	if rule.Count > 0 || !rule.EndDate.IsZero() {
+		last := len(response.Occurrences) - 1
+		if last < 0 || !response.Occurrences[last].ScheduledAt.After(now) {
+			response.Status = SeriesStatusCompleted
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
 // handleAutoSchedulePost handles POST /api/v1/posts/auto-schedule
 func (m *MockServer) handleAutoSchedulePost(w http.ResponseWriter, r *http.Request) {
 	var req AutoScheduleRequest
@@ -1252,6 +2976,7 @@ func (m *MockServer) handleRecyclePost(w http.ResponseWriter, r *http.Request) {
 		_ = json.NewEncoder(w).Encode(ErrorResponse{
 			Error:   "not_found",
 			Message: "Post not found",
+			Code:    "post_not_found",
 		})
 		return
 	}
@@ -0,0 +1,87 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/thrawn/publer.go/v1"
+)
+
+func TestSupportedNetworks(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	networks, err := client.SupportedNetworks(context.Background())
+	require.NoError(t, err)
+	require.Len(t, networks, 4)
+
+	byID := make(map[string]v1.NetworkInfo)
+	for _, network := range networks {
+		byID[network.ID] = network
+	}
+
+	twitter := byID["twitter"]
+	assert.Equal(t, "Twitter", twitter.Name)
+	assert.Equal(t, 280, twitter.CharLimit)
+	assert.Equal(t, []string{"image", "video", "gif"}, twitter.MediaTypes)
+	assert.Equal(t, 4, twitter.MaxMedia)
+
+	linkedin := byID["linkedin"]
+	assert.Equal(t, 3000, linkedin.CharLimit)
+	assert.Contains(t, linkedin.MediaTypes, "document")
+}
+
+func TestSupportedNetworksCached(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	first, err := client.SupportedNetworks(context.Background())
+	require.NoError(t, err)
+
+	second, err := client.SupportedNetworks(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, server.CallCount("GET", "/api/v1/networks"))
+
+	// Changing a returned slice, or the media types within it, leaves the cache intact
+	require.NotEmpty(t, first)
+	require.NotEmpty(t, second[0].MediaTypes)
+	first[0] = v1.NetworkInfo{}
+	mediaType := second[0].MediaTypes[0]
+	second[0].MediaTypes[0] = "changed"
+	third, err := client.SupportedNetworks(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, mediaType, third[0].MediaTypes[0])
+	second[0].MediaTypes[0] = mediaType
+	assert.Equal(t, second, third)
+}
+
+func TestSupportedNetworksErrorNotCached(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetErrorResponse("GET", "/api/v1/networks", 0, 500, v1.ErrorResponse{Error: "Internal Server Error"}, nil)
+
+	_, err := client.SupportedNetworks(context.Background())
+	require.Error(t, err)
+
+	server.Reset()
+
+	networks, err := client.SupportedNetworks(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, networks, 4)
+}
@@ -4,15 +4,23 @@ import "time"
 
 // ListPostsRequest represents request for listing posts
 type ListPostsRequest struct {
-	State      string    `json:"state,omitempty"`
-	States     []string  `json:"state[],omitempty"`
-	From       time.Time `json:"from,omitempty"`
-	To         time.Time `json:"to,omitempty"`
-	Page       int       `json:"page,omitempty"`
-	AccountIDs []string  `json:"account_ids[],omitempty"`
-	Query      string    `json:"query,omitempty"`
-	PostType   string    `json:"postType,omitempty"`
-	MemberID   string    `json:"member_id,omitempty"`
+	State          string    `json:"state,omitempty"`
+	States         []string  `json:"state[],omitempty"`
+	From           time.Time `json:"from,omitempty"` // filters by scheduled time
+	To             time.Time `json:"to,omitempty"`
+	CreatedFrom    time.Time `json:"created_from,omitempty"` // filters by creation time
+	CreatedTo      time.Time `json:"created_to,omitempty"`
+	Page           int       `json:"page,omitempty"`
+	AccountIDs     []string  `json:"account_ids[],omitempty"`
+	Query          string    `json:"query,omitempty"`
+	PostType       string    `json:"postType,omitempty"`
+	MemberID       string    `json:"member_id,omitempty"`
+	HasMedia       *bool     `json:"has_media,omitempty"` // nil means don't filter
+	IncludeTrashed bool      `json:"include_trashed,omitempty"`
+
+	// Fields limits the returned posts to the given JSON field names (e.g. "id", "state").
+	// Omitted fields come back as zero values. Empty returns all fields.
+	Fields []string `json:"fields[],omitempty"`
 }
 
 // ListPostsResponse represents paginated posts response
@@ -26,12 +34,46 @@ type ListPostsResponse struct {
 
 // PublishRequest represents immediate post publishing
 type PublishRequest struct {
-	Text     string   `json:"text"`
-	Accounts []string `json:"accounts"`
-	Media    []Media  `json:"media,omitempty"`
+	Text     string            `json:"text"`
+	Accounts []string          `json:"accounts"`
+	Media    []Media           `json:"media,omitempty"`
+	PostKind string            `json:"post_kind,omitempty"` // feed, story, reel or short; checked per network by the server
+	Variants map[string]string `json:"variants,omitempty"`  // network -> text, Text is the fallback
+	Link     string            `json:"link,omitempty"`      // shared as a link preview card, separate from Text
+	Thread   []string          `json:"thread,omitempty"`    // follow-up parts posted as replies after Text; network support is checked by the server
+	Poll     *Poll             `json:"poll,omitempty"`
+	Labels   []string          `json:"labels,omitempty"`
+
+	// ExpiresAt, when set, deletes the post from the network at that time
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+
+	// RespectQuietHours defers publishing until the quiet hours of every target
+	// account have passed. The deferred time is reported in PublishResponse.ScheduledAt.
+	RespectQuietHours bool `json:"respect_quiet_hours,omitempty"`
+
+	// UTM, when set, is appended to every URL in Text, Link, Variants and Thread
+	// before sending
+	UTM *UTMParams `json:"-"`
 }
 
-// PublishResponse contains job ID for async processing
+// PublishResponse contains job ID for async processing. Since it also carries
+// ScheduledAt it no longer converts to GetJobStatusRequest; use
+// GetJobStatusRequest{JobID: resp.JobID} instead.
 type PublishResponse struct {
 	JobID string `json:"job_id"`
+
+	// ScheduledAt is when the post will be published if RespectQuietHours deferred
+	// it, and zero when it is published immediately
+	ScheduledAt time.Time `json:"scheduled_at,omitzero"`
+}
+
+// DuplicateCheckRequest asks whether text matches content recently posted to an account
+type DuplicateCheckRequest struct {
+	AccountID string `json:"account_id"`
+	Text      string `json:"text"`
+}
+
+// DuplicateCheckResponse reports whether the content would be flagged as a duplicate
+type DuplicateCheckResponse struct {
+	Duplicate bool `json:"duplicate"`
 }
@@ -1,6 +1,9 @@
 package v1
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // RecurringPostRequest represents recurring post configuration
 type RecurringPostRequest struct {
@@ -10,6 +13,20 @@ type RecurringPostRequest struct {
 	Recurrence RecurrenceRule `json:"recurrence"`
 }
 
+// Validate checks the request before it is sent to the API
+func (r RecurringPostRequest) Validate() error {
+	if r.Text == "" {
+		return &ValidationError{Field: "text", Message: "Text field is required"}
+	}
+	if len(r.Accounts) == 0 {
+		return &ValidationError{Field: "accounts", Message: "At least one account is required"}
+	}
+	if r.Recurrence.Frequency == "" {
+		return &ValidationError{Field: "recurrence.frequency", Message: "Recurrence frequency is required"}
+	}
+	return nil
+}
+
 // RecurrenceRule defines how posts repeat
 type RecurrenceRule struct {
 	Frequency  string    `json:"frequency"`           // daily, weekly, monthly
@@ -19,6 +36,58 @@ type RecurrenceRule struct {
 	Count      int       `json:"count,omitempty"` // alternative to end_date
 }
 
+// NextOccurrences returns up to limit occurrence times of a series that starts at
+// start. The series ends after Count occurrences or at EndDate when either is set.
+// Weekly rules with DaysOfWeek occur on each listed day of every Interval-th week,
+// with weeks starting on Sunday. An unknown frequency has no occurrences.
+func (r RecurrenceRule) NextOccurrences(start time.Time, limit int) []time.Time {
+	interval := max(r.Interval, 1)
+	if r.Count > 0 {
+		limit = min(limit, r.Count)
+	}
+
+	var days map[time.Weekday]bool
+	if r.Frequency == "weekly" && len(r.DaysOfWeek) > 0 {
+		days = make(map[time.Weekday]bool)
+		for _, name := range r.DaysOfWeek {
+			for day := time.Sunday; day <= time.Saturday; day++ {
+				if strings.EqualFold(name, day.String()) {
+					days[day] = true
+				}
+			}
+		}
+		if len(days) == 0 {
+			return nil
+		}
+	}
+
+	var occurrences []time.Time
+	for i := 0; len(occurrences) < limit; i++ {
+		var next time.Time
+		switch {
+		case days != nil:
+			next = start.AddDate(0, 0, i)
+			week := (i + int(start.Weekday())) / 7
+			if week%interval != 0 || !days[next.Weekday()] {
+				continue
+			}
+		case r.Frequency == "daily":
+			next = start.AddDate(0, 0, i*interval)
+		case r.Frequency == "weekly":
+			next = start.AddDate(0, 0, 7*i*interval)
+		case r.Frequency == "monthly":
+			next = start.AddDate(0, i*interval, 0)
+		default:
+			return nil
+		}
+		if !r.EndDate.IsZero() && next.After(r.EndDate) {
+			break
+		}
+		occurrences = append(occurrences, next)
+	}
+	return occurrences
+}
+
 // AutoScheduleRequest represents auto-scheduling configuration
 type AutoScheduleRequest struct {
 	Text      string    `json:"text"`
@@ -29,6 +98,23 @@ type AutoScheduleRequest struct {
 	Slots     int       `json:"slots"` // number of times to post in date range
 }
 
+// Validate checks the request before it is sent to the API
+func (r AutoScheduleRequest) Validate() error {
+	if r.Text == "" {
+		return &ValidationError{Field: "text", Message: "Text field is required"}
+	}
+	if len(r.Accounts) == 0 {
+		return &ValidationError{Field: "accounts", Message: "At least one account is required"}
+	}
+	if r.Slots <= 0 {
+		return &ValidationError{Field: "slots", Message: "Slots must be greater than 0"}
+	}
+	if r.EndDate.Before(r.StartDate) {
+		return &ValidationError{Field: "end_date", Message: "End date must be after start date"}
+	}
+	return nil
+}
+
 // RecyclePostRequest represents content recycling configuration
 type RecyclePostRequest struct {
 	PostID    string    `json:"post_id"`
@@ -38,9 +124,27 @@ type RecyclePostRequest struct {
 	MaxCount  int       `json:"max_count"` // maximum times to recycle
 }
 
+// Validate checks the request before it is sent to the API
+func (r RecyclePostRequest) Validate() error {
+	if r.PostID == "" {
+		return &ValidationError{Field: "post_id", Message: "Post ID is required"}
+	}
+	if r.Frequency == "" {
+		return &ValidationError{Field: "frequency", Message: "Frequency is required"}
+	}
+	if r.MaxCount <= 0 {
+		return &ValidationError{Field: "max_count", Message: "Max count must be greater than 0"}
+	}
+	if r.EndDate.Before(r.StartDate) {
+		return &ValidationError{Field: "end_date", Message: "End date must be after start date"}
+	}
+	return nil
+}
+
 // RecurringPostResponse contains job ID for recurring post setup
 type RecurringPostResponse struct {
-	JobID string `json:"job_id"`
+	JobID    string `json:"job_id"`
+	SeriesID string `json:"series_id"` // pass to GetSeries to follow the series
 }
 
 // AutoScheduleResponse contains job ID for auto-scheduling
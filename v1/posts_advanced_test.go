@@ -120,6 +120,97 @@ func TestCreateRecurringPostValidation(t *testing.T) {
 	}
 }
 
+func TestGetSeries(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	// Monday, January 6th 2025
+	start := time.Date(2025, time.January, 6, 10, 0, 0, 0, time.UTC)
+
+	for _, test := range []struct {
+		name       string
+		recurrence v1.RecurrenceRule
+		now        time.Time
+		expected   []time.Time
+		status     string
+	}{
+		{
+			name:       "DailyCount",
+			recurrence: v1.RecurrenceRule{Frequency: "daily", Interval: 2, Count: 3},
+			now:        start,
+			expected:   []time.Time{start, start.AddDate(0, 0, 2), start.AddDate(0, 0, 4)},
+			status:     v1.SeriesStatusActive,
+		},
+		{
+			name: "WeeklyDaysEndDate",
+			recurrence: v1.RecurrenceRule{
+				Frequency:  "weekly",
+				Interval:   1,
+				DaysOfWeek: []string{"monday", "friday"},
+				EndDate:    start.AddDate(0, 0, 13),
+			},
+			now: start,
+			expected: []time.Time{
+				start, start.AddDate(0, 0, 4), start.AddDate(0, 0, 7), start.AddDate(0, 0, 11),
+			},
+			status: v1.SeriesStatusActive,
+		},
+		{
+			name:       "MonthlyEndDateCompleted",
+			recurrence: v1.RecurrenceRule{Frequency: "monthly", Interval: 1, EndDate: start.AddDate(0, 2, 0)},
+			now:        start.AddDate(1, 0, 0),
+			expected:   []time.Time{start, start.AddDate(0, 1, 0), start.AddDate(0, 2, 0)},
+			status:     v1.SeriesStatusCompleted,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.SetNow(func() time.Time { return start })
+
+			var resp v1.RecurringPostResponse
+			err := client.CreateRecurringPost(context.Background(), v1.RecurringPostRequest{
+				Text:       "Recurring post",
+				Accounts:   []string{"account-1"},
+				Recurrence: test.recurrence,
+			}, &resp)
+			require.NoError(t, err)
+			require.NotEmpty(t, resp.SeriesID)
+
+			server.SetNow(func() time.Time { return test.now })
+			series, err := client.GetSeries(context.Background(), resp.SeriesID)
+			require.NoError(t, err)
+			assert.Equal(t, resp.SeriesID, series.ID)
+			assert.Equal(t, test.status, series.Status)
+			require.Len(t, series.Occurrences, len(test.expected))
+			for i, occurrence := range series.Occurrences {
+				assert.True(t, test.expected[i].Equal(occurrence.ScheduledAt))
+				assert.Equal(t, "Recurring post", occurrence.Text)
+			}
+		})
+	}
+}
+
+func TestGetSeriesErrors(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	_, err := client.GetSeries(context.Background(), "")
+	require.ErrorContains(t, err, "invalid series ID: series ID cannot be empty")
+
+	_, err = client.GetSeries(context.Background(), "../admin")
+	require.ErrorContains(t, err, "invalid series ID")
+
+	_, err = client.GetSeries(context.Background(), "series-999")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, v1.ErrNotFound)
+}
+
 func TestAutoSchedulePost(t *testing.T) {
 	server := v1.SpawnMockServer()
 	defer func() { _ = server.Stop() }()
@@ -382,4 +473,135 @@ func TestRecurrencePatterns(t *testing.T) {
 			assert.NotEmpty(t, resp.JobID)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestAdvancedRequestsValidateClientSide(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	now := time.Now()
+	recurrence := v1.RecurrenceRule{Frequency: "daily", Interval: 1, Count: 5}
+
+	tests := []struct {
+		name      string
+		call      func() error
+		path      string
+		wantField string
+		wantErr   string
+	}{
+		{
+			name: "recurring missing text",
+			call: func() error {
+				var resp v1.RecurringPostResponse
+				return client.CreateRecurringPost(context.Background(), v1.RecurringPostRequest{
+					Accounts:   []string{"account-1"},
+					Recurrence: recurrence,
+				}, &resp)
+			},
+			path:      "/api/v1/posts/recurring",
+			wantField: "text",
+			wantErr:   "Text field is required",
+		},
+		{
+			name: "recurring missing accounts",
+			call: func() error {
+				var resp v1.RecurringPostResponse
+				return client.CreateRecurringPost(context.Background(), v1.RecurringPostRequest{
+					Text:       "Post text",
+					Recurrence: recurrence,
+				}, &resp)
+			},
+			path:      "/api/v1/posts/recurring",
+			wantField: "accounts",
+			wantErr:   "At least one account is required",
+		},
+		{
+			name: "recurring missing frequency",
+			call: func() error {
+				var resp v1.RecurringPostResponse
+				return client.CreateRecurringPost(context.Background(), v1.RecurringPostRequest{
+					Text:       "Post text",
+					Accounts:   []string{"account-1"},
+					Recurrence: v1.RecurrenceRule{Interval: 1, Count: 5},
+				}, &resp)
+			},
+			path:      "/api/v1/posts/recurring",
+			wantField: "recurrence.frequency",
+			wantErr:   "Recurrence frequency is required",
+		},
+		{
+			name: "auto-schedule invalid slots",
+			call: func() error {
+				var resp v1.AutoScheduleResponse
+				return client.AutoSchedulePost(context.Background(), v1.AutoScheduleRequest{
+					Text:      "Post text",
+					Accounts:  []string{"account-1"},
+					StartDate: now,
+					EndDate:   now.Add(24 * time.Hour),
+				}, &resp)
+			},
+			path:      "/api/v1/posts/auto-schedule",
+			wantField: "slots",
+			wantErr:   "Slots must be greater than 0",
+		},
+		{
+			name: "auto-schedule invalid date range",
+			call: func() error {
+				var resp v1.AutoScheduleResponse
+				return client.AutoSchedulePost(context.Background(), v1.AutoScheduleRequest{
+					Text:      "Post text",
+					Accounts:  []string{"account-1"},
+					StartDate: now.Add(24 * time.Hour),
+					EndDate:   now,
+					Slots:     3,
+				}, &resp)
+			},
+			path:      "/api/v1/posts/auto-schedule",
+			wantField: "end_date",
+			wantErr:   "End date must be after start date",
+		},
+		{
+			name: "recycle missing post ID",
+			call: func() error {
+				var resp v1.RecyclePostResponse
+				return client.RecyclePost(context.Background(), v1.RecyclePostRequest{
+					Frequency: "daily",
+					MaxCount:  3,
+				}, &resp)
+			},
+			path:      "/api/v1/posts/recycle",
+			wantField: "post_id",
+			wantErr:   "Post ID is required",
+		},
+		{
+			name: "recycle invalid max count",
+			call: func() error {
+				var resp v1.RecyclePostResponse
+				return client.RecyclePost(context.Background(), v1.RecyclePostRequest{
+					PostID:    "post-1",
+					Frequency: "daily",
+				}, &resp)
+			},
+			path:      "/api/v1/posts/recycle",
+			wantField: "max_count",
+			wantErr:   "Max count must be greater than 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+
+			err := test.call()
+			require.Error(t, err)
+
+			var validationErr *v1.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, test.wantField, validationErr.Field)
+			assert.Equal(t, test.wantErr, validationErr.Message)
+			assert.Equal(t, 0, server.CallCount("POST", test.path))
+		})
+	}
+}
@@ -1,13 +1,24 @@
 package v1
 
-import "time"
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
 
-// BulkPost represents a single post in bulk operation
+// BulkPost represents a single post in bulk operation. In a bulk schedule request
+// PublishNow publishes the post immediately instead of at ScheduledAt, letting one
+// request mix immediate and scheduled posts.
 type BulkPost struct {
 	Text        string    `json:"text"`
 	Accounts    []string  `json:"accounts"`
-	ScheduledAt time.Time `json:"scheduled_at,omitempty"`
+	ScheduledAt time.Time `json:"scheduled_at,omitzero"`
 	Media       []Media   `json:"media,omitempty"`
+	PublishNow  bool      `json:"publish_now,omitempty"`
 }
 
 // BulkPublishRequest represents bulk immediate publishing
@@ -25,7 +36,170 @@ type BulkScheduleRequest struct {
 	Posts []BulkPost `json:"posts"`
 }
 
-// BulkScheduleResponse contains job ID for async processing
+// BulkScheduleResponse contains job ID for async processing along with how each
+// post in the request was handled. Since it carries Items it no longer converts to
+// GetJobStatusRequest; use GetJobStatusRequest{JobID: resp.JobID} instead.
 type BulkScheduleResponse struct {
-	JobID string `json:"job_id"`
+	JobID string           `json:"job_id"`
+	Items []BulkItemResult `json:"items,omitempty"`
+}
+
+// BulkItemResult reports how one post of a bulk schedule request was handled
+type BulkItemResult struct {
+	Index   int      `json:"index"`              // zero based index of the post in the request
+	State   string   `json:"state"`              // published or scheduled
+	PostIDs []string `json:"post_ids,omitempty"` // set once the posts are created
+}
+
+// bulkCSVColumns are the columns read by ParseBulkPostsCSV, in their default order
+var bulkCSVColumns = []string{"text", "accounts", "scheduled_at", "media_urls"}
+
+// ParseBulkPostsCSV reads a content calendar CSV into a bulk schedule request. Columns
+// are text, accounts, scheduled_at and an optional media_urls. An optional header row
+// naming the columns may list them in any order. Accounts and media URLs are separated
+// by semicolons and scheduled_at is RFC 3339. Every invalid row is reported, numbered
+// by its line in the file.
+func ParseBulkPostsCSV(r io.Reader) (BulkScheduleRequest, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return BulkScheduleRequest{}, fmt.Errorf("failed to read CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return BulkScheduleRequest{}, fmt.Errorf("CSV contains no rows")
+	}
+
+	columns := map[string]int{"text": 0, "accounts": 1, "scheduled_at": 2, "media_urls": 3}
+	first := 0
+	if strings.EqualFold(strings.TrimSpace(records[0][0]), "text") || isBulkCSVHeader(records[0]) {
+		columns, err = bulkCSVHeader(records[0])
+		if err != nil {
+			return BulkScheduleRequest{}, fmt.Errorf("row 1: %w", err)
+		}
+		first = 1
+	}
+
+	var req BulkScheduleRequest
+	var errs []error
+	for i := first; i < len(records); i++ {
+		post, err := parseBulkCSVRow(records[i], columns)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("row %d: %w", i+1, err))
+			continue
+		}
+		req.Posts = append(req.Posts, post)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return BulkScheduleRequest{}, err
+	}
+	if len(req.Posts) == 0 {
+		return BulkScheduleRequest{}, fmt.Errorf("CSV contains no posts")
+	}
+	return req, nil
+}
+
+// isBulkCSVHeader reports whether every field of the record is a known column name
+func isBulkCSVHeader(record []string) bool {
+	for _, field := range record {
+		name := strings.ToLower(strings.TrimSpace(field))
+		known := false
+		for _, column := range bulkCSVColumns {
+			if name == column {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return false
+		}
+	}
+	return true
+}
+
+// bulkCSVHeader maps column names in the header row to their index
+func bulkCSVHeader(record []string) (map[string]int, error) {
+	columns := make(map[string]int)
+	for i, field := range record {
+		name := strings.ToLower(strings.TrimSpace(field))
+		if _, dup := columns[name]; dup {
+			return nil, fmt.Errorf("duplicate column %q", name)
+		}
+		columns[name] = i
+	}
+	for _, required := range bulkCSVColumns[:3] {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing required column %q", required)
+		}
+	}
+	for name := range columns {
+		if !isBulkCSVHeader([]string{name}) {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+	}
+	return columns, nil
+}
+
+// parseBulkCSVRow converts a single CSV record into a BulkPost
+func parseBulkCSVRow(record []string, columns map[string]int) (BulkPost, error) {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	post := BulkPost{Text: field("text")}
+	if post.Text == "" {
+		return BulkPost{}, fmt.Errorf("text is required")
+	}
+
+	post.Accounts = splitBulkCSVList(field("accounts"))
+	if len(post.Accounts) == 0 {
+		return BulkPost{}, fmt.Errorf("at least one account is required")
+	}
+
+	scheduledAt := field("scheduled_at")
+	if scheduledAt == "" {
+		return BulkPost{}, fmt.Errorf("scheduled_at is required")
+	}
+	t, err := time.Parse(time.RFC3339, scheduledAt)
+	if err != nil {
+		return BulkPost{}, fmt.Errorf("invalid scheduled_at %q: must be RFC 3339", scheduledAt)
+	}
+	post.ScheduledAt = t
+
+	for _, mediaURL := range splitBulkCSVList(field("media_urls")) {
+		post.Media = append(post.Media, Media{URL: mediaURL, Type: mediaTypeFromURL(mediaURL)})
+	}
+	return post, nil
+}
+
+// splitBulkCSVList splits a semicolon separated cell, dropping empty entries
+func splitBulkCSVList(cell string) []string {
+	var items []string
+	for _, item := range strings.Split(cell, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// mediaTypeFromURL guesses the media type from the file extension, defaulting to image
+func mediaTypeFromURL(mediaURL string) string {
+	if i := strings.IndexAny(mediaURL, "?#"); i >= 0 {
+		mediaURL = mediaURL[:i]
+	}
+	switch strings.ToLower(path.Ext(mediaURL)) {
+	case ".mp4", ".mov", ".avi", ".webm":
+		return "video"
+	case ".gif":
+		return "gif"
+	}
+	return "image"
 }
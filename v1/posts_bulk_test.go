@@ -2,6 +2,8 @@ package v1_test
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -84,7 +86,7 @@ func TestBulkSchedulePosts(t *testing.T) {
 
 	// Verify job status endpoint returns status for the created job
 	var jobResp v1.GetJobStatusResponse
-	err = client.GetJobStatus(context.Background(), v1.GetJobStatusRequest(resp), &jobResp)
+	err = client.GetJobStatus(context.Background(), v1.GetJobStatusRequest{JobID: resp.JobID}, &jobResp)
 	require.NoError(t, err)
 	assert.Equal(t, resp.JobID, jobResp.ID)
 	assert.Equal(t, "pending", jobResp.Status)
@@ -279,3 +281,574 @@ func TestBulkSchedulePostsValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestBulkPublishStrictValidation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetStrictValidation(true)
+
+	req := v1.BulkPublishRequest{
+		Posts: []v1.BulkPost{
+			{Text: "Valid post", Accounts: []string{"account-1"}},
+			{Text: "Missing accounts"},
+		},
+	}
+
+	var resp v1.BulkPublishResponse
+	err := client.BulkPublish(context.Background(), req, &resp)
+	require.Error(t, err)
+	require.ErrorContains(t, err, "Post 2: At least one account is required")
+}
+
+func TestParseBulkPostsCSV(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		csv      string
+		expected v1.BulkScheduleRequest
+	}{
+		{
+			name: "WithHeader",
+			csv: "text,accounts,scheduled_at,media_urls\n" +
+				"Monday tip,acc-1;acc-2,2025-06-02T09:00:00Z,https://example.com/tip.jpg\n" +
+				"\"Tuesday, with comma\",acc-1,2025-06-03T09:00:00Z,\n",
+			expected: v1.BulkScheduleRequest{Posts: []v1.BulkPost{
+				{
+					Text:        "Monday tip",
+					Accounts:    []string{"acc-1", "acc-2"},
+					ScheduledAt: time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC),
+					Media:       []v1.Media{{URL: "https://example.com/tip.jpg", Type: "image"}},
+				},
+				{
+					Text:        "Tuesday, with comma",
+					Accounts:    []string{"acc-1"},
+					ScheduledAt: time.Date(2025, 6, 3, 9, 0, 0, 0, time.UTC),
+				},
+			}},
+		},
+		{
+			name: "WithoutHeader",
+			csv:  "Launch day,acc-1,2025-06-02T09:00:00Z,https://example.com/a.mp4;https://example.com/b.gif\n",
+			expected: v1.BulkScheduleRequest{Posts: []v1.BulkPost{
+				{
+					Text:        "Launch day",
+					Accounts:    []string{"acc-1"},
+					ScheduledAt: time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC),
+					Media: []v1.Media{
+						{URL: "https://example.com/a.mp4", Type: "video"},
+						{URL: "https://example.com/b.gif", Type: "gif"},
+					},
+				},
+			}},
+		},
+		{
+			name: "ReorderedHeaderWithoutMedia",
+			csv: "scheduled_at,text,accounts\n" +
+				"2025-06-02T09:00:00Z,Reordered,acc-1\n",
+			expected: v1.BulkScheduleRequest{Posts: []v1.BulkPost{
+				{
+					Text:        "Reordered",
+					Accounts:    []string{"acc-1"},
+					ScheduledAt: time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC),
+				},
+			}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := v1.ParseBulkPostsCSV(strings.NewReader(test.csv))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, req)
+		})
+	}
+}
+
+func TestParseBulkPostsCSVErrors(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		csv          string
+		expectedErrs []string
+	}{
+		{
+			name: "RowErrors",
+			csv: "text,accounts,scheduled_at\n" +
+				",acc-1,2025-06-02T09:00:00Z\n" +
+				"No accounts,,2025-06-02T09:00:00Z\n" +
+				"Bad time,acc-1,next tuesday\n" +
+				"Good row,acc-1,2025-06-02T09:00:00Z\n",
+			expectedErrs: []string{
+				"row 2: text is required",
+				"row 3: at least one account is required",
+				`row 4: invalid scheduled_at "next tuesday": must be RFC 3339`,
+			},
+		},
+		{
+			name:         "MissingColumn",
+			csv:          "text,accounts\nHello,acc-1\n",
+			expectedErrs: []string{`row 1: missing required column "scheduled_at"`},
+		},
+		{
+			name:         "UnknownColumn",
+			csv:          "text,accounts,scheduled_at,labels\nHello,acc-1,2025-06-02T09:00:00Z,promo\n",
+			expectedErrs: []string{`row 1: unknown column "labels"`},
+		},
+		{
+			name:         "HeaderOnly",
+			csv:          "text,accounts,scheduled_at,media_urls\n",
+			expectedErrs: []string{"CSV contains no posts"},
+		},
+		{
+			name:         "Empty",
+			csv:          "",
+			expectedErrs: []string{"CSV contains no rows"},
+		},
+		{
+			name:         "Malformed",
+			csv:          "\"unterminated,acc-1,2025-06-02T09:00:00Z\n",
+			expectedErrs: []string{"failed to read CSV"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := v1.ParseBulkPostsCSV(strings.NewReader(test.csv))
+			require.Error(t, err)
+			for _, expected := range test.expectedErrs {
+				assert.Contains(t, err.Error(), expected)
+			}
+			assert.Empty(t, req.Posts)
+		})
+	}
+}
+
+func TestJobResultItemErrors(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	const jobID = "test-bulk-job"
+	server.Reset()
+	server.SetJobStatus(jobID, "completed", 100, &v1.JobResult{
+		Success: false,
+		PostIDs: []string{"post-1"},
+		Error:   "Post 2 failed: invalid account",
+		Data: map[string]interface{}{
+			"successful_posts": 1,
+			"failed_posts":     2,
+			"errors": []interface{}{
+				map[string]interface{}{
+					"post_index": 1,
+					"error":      "invalid account",
+				},
+				map[string]interface{}{
+					"post_index": 2,
+					"error":      "text too long",
+				},
+			},
+		},
+	}, "")
+
+	var jobResp v1.GetJobStatusResponse
+	err := client.GetJobStatus(context.Background(), v1.GetJobStatusRequest{JobID: jobID}, &jobResp)
+	require.NoError(t, err)
+	require.NotNil(t, jobResp.Result)
+
+	itemErrors, err := jobResp.Result.ItemErrors()
+	require.NoError(t, err)
+	assert.Equal(t, []v1.ItemError{
+		{PostIndex: 1, Error: "invalid account"},
+		{PostIndex: 2, Error: "text too long"},
+	}, itemErrors)
+}
+
+func TestJobResultItemErrorsEdgeCases(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		result      v1.JobResult
+		expected    []v1.ItemError
+		expectedErr string
+	}{
+		{
+			name:   "NoData",
+			result: v1.JobResult{Success: true},
+		},
+		{
+			name:   "NoErrors",
+			result: v1.JobResult{Data: map[string]interface{}{"successful_posts": 2}},
+		},
+		{
+			name: "Malformed",
+			result: v1.JobResult{Data: map[string]interface{}{
+				"errors": "something went wrong",
+			}},
+			expectedErr: "failed to decode job errors",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			itemErrors, err := test.result.ItemErrors()
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, itemErrors)
+		})
+	}
+}
+
+func TestBulkScheduleMixedPublishNow(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+
+	scheduledAt := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	var resp v1.BulkScheduleResponse
+	err := client.BulkSchedule(context.Background(), v1.BulkScheduleRequest{
+		Posts: []v1.BulkPost{
+			{Text: "Right away", Accounts: []string{"account-1"}, PublishNow: true},
+			{Text: "Later on", Accounts: []string{"account-1"}, ScheduledAt: scheduledAt},
+		},
+	}, &resp)
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 2)
+	assert.Equal(t, 0, resp.Items[0].Index)
+	assert.Equal(t, "published", resp.Items[0].State)
+	assert.Equal(t, 1, resp.Items[1].Index)
+	assert.Equal(t, "scheduled", resp.Items[1].State)
+
+	require.Len(t, resp.Items[0].PostIDs, 1)
+	var published v1.GetPostResponse
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: resp.Items[0].PostIDs[0]}, &published)
+	require.NoError(t, err)
+	assert.Equal(t, "Right away", published.Text)
+	assert.Equal(t, "published", published.State)
+	assert.True(t, published.ScheduledAt.IsZero())
+
+	require.Len(t, resp.Items[1].PostIDs, 1)
+	var scheduled v1.GetPostResponse
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: resp.Items[1].PostIDs[0]}, &scheduled)
+	require.NoError(t, err)
+	assert.Equal(t, "Later on", scheduled.Text)
+	assert.Equal(t, "scheduled", scheduled.State)
+	assert.True(t, scheduledAt.Equal(scheduled.ScheduledAt))
+}
+
+func TestBulkSchedulePublishNowWithScheduledAt(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	var resp v1.BulkScheduleResponse
+	err := client.BulkSchedule(context.Background(), v1.BulkScheduleRequest{
+		Posts: []v1.BulkPost{
+			{Text: "Fine", Accounts: []string{"account-1"}, ScheduledAt: time.Now().Add(time.Hour)},
+			{Text: "Conflicting", Accounts: []string{"account-1"}, PublishNow: true, ScheduledAt: time.Now().Add(time.Hour)},
+		},
+	}, &resp)
+	require.EqualError(t, err, "post 2: scheduled time cannot be set when publishing now")
+}
+
+func TestBulkPostOmitsZeroScheduledAt(t *testing.T) {
+	encoded, err := json.Marshal(v1.BulkPost{Text: "Now", Accounts: []string{"account-1"}, PublishNow: true})
+	require.NoError(t, err)
+	assert.NotContains(t, string(encoded), "scheduled_at")
+	assert.Contains(t, string(encoded), `"publish_now":true`)
+}
+
+func TestBulkScheduleSync(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	const jobID = "test-bulk-sync"
+	opts := v1.WaitOptions{
+		InitialDelay: 10 * time.Millisecond,
+		MaxDelay:     20 * time.Millisecond,
+		Jitter:       5 * time.Millisecond,
+	}
+	req := v1.BulkScheduleRequest{
+		Posts: []v1.BulkPost{
+			{Text: "First", Accounts: []string{"acc-001"}, ScheduledAt: time.Now().Add(time.Hour)},
+			{Text: "Second", Accounts: []string{"acc-002"}, ScheduledAt: time.Now().Add(time.Hour)},
+			{Text: "Third", Accounts: []string{"acc-003"}, ScheduledAt: time.Now().Add(time.Hour)},
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		server.Reset()
+		server.SetResponse("POST", "/api/v1/posts/schedule", 200, v1.BulkScheduleResponse{JobID: jobID})
+		server.SetJobStatus(jobID, "completed", 100, &v1.JobResult{
+			Success: true,
+			PostIDs: []string{"post-001", "post-002", "post-003"},
+		}, "")
+
+		postIDs, err := client.BulkScheduleSync(context.Background(), req, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"post-001", "post-002", "post-003"}, postIDs)
+	})
+
+	t.Run("PartialFailure", func(t *testing.T) {
+		server.Reset()
+		server.SetResponse("POST", "/api/v1/posts/schedule", 200, v1.BulkScheduleResponse{JobID: jobID})
+		server.SetJobProgression(jobID, []v1.JobStatus{
+			{ID: jobID, Status: "working", Progress: 50},
+			{ID: jobID, Status: "completed", Progress: 100, Result: &v1.JobResult{
+				Success: false,
+				PostIDs: []string{"post-001"},
+				Error:   "2 posts failed",
+				Data: map[string]interface{}{
+					"errors": []interface{}{
+						map[string]interface{}{"post_index": 1, "error": "invalid account"},
+						map[string]interface{}{"post_index": 2, "error": "text too long"},
+					},
+				},
+			}},
+		})
+		advance := time.AfterFunc(30*time.Millisecond, func() { server.AdvanceJobState(jobID) })
+		defer advance.Stop()
+
+		postIDs, err := client.BulkScheduleSync(context.Background(), req, opts)
+		require.Error(t, err)
+		assert.Equal(t, []string{"post-001"}, postIDs)
+		assert.ErrorContains(t, err, "post 2: invalid account")
+		assert.ErrorContains(t, err, "post 3: text too long")
+	})
+
+	t.Run("JobFailed", func(t *testing.T) {
+		server.Reset()
+		server.SetResponse("POST", "/api/v1/posts/schedule", 200, v1.BulkScheduleResponse{JobID: jobID})
+		server.SetJobStatus(jobID, "failed", 0, nil, "Processing failed")
+
+		postIDs, err := client.BulkScheduleSync(context.Background(), req, opts)
+		require.Error(t, err)
+		assert.Empty(t, postIDs)
+		assert.ErrorContains(t, err, "Processing failed")
+	})
+}
+
+func TestBulkScheduleWithProgress(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	const jobID = "test-bulk-progress"
+	server.Reset()
+	server.SetResponse("POST", "/api/v1/posts/schedule", 200, v1.BulkScheduleResponse{JobID: jobID})
+	server.SetJobProgression(jobID, []v1.JobStatus{
+		{ID: jobID, Status: "pending", Progress: 0},
+		{ID: jobID, Status: "working", Progress: 50},
+		{ID: jobID, Status: "completed", Progress: 100, Result: &v1.JobResult{
+			Success: true,
+			PostIDs: []string{"post-001", "post-002"},
+		}},
+	})
+
+	ctx := v1.WithWaitOptions(context.Background(), v1.WaitOptions{
+		InitialDelay: 10 * time.Millisecond,
+		MaxDelay:     20 * time.Millisecond,
+		Jitter:       5 * time.Millisecond,
+	})
+	progress, results := client.BulkScheduleWithProgress(ctx, v1.BulkScheduleRequest{
+		Posts: []v1.BulkPost{
+			{Text: "First", Accounts: []string{"acc-001"}, ScheduledAt: time.Now().Add(time.Hour)},
+			{Text: "Second", Accounts: []string{"acc-002"}, ScheduledAt: time.Now().Add(time.Hour)},
+		},
+	})
+
+	var seen []int
+	for status := range progress {
+		seen = append(seen, status.Progress)
+		server.AdvanceJobState(jobID)
+	}
+	result := <-results
+	require.NoError(t, result.Err)
+	assert.Equal(t, []int{0, 50, 100}, seen)
+	assert.Equal(t, []string{"post-001", "post-002"}, result.PostIDs)
+}
+
+func TestBulkScheduleWithProgressCancel(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	const jobID = "test-bulk-progress-cancel"
+	server.Reset()
+	server.SetResponse("POST", "/api/v1/posts/schedule", 200, v1.BulkScheduleResponse{JobID: jobID})
+	server.SetJobStatus(jobID, "working", 10, nil, "")
+
+	ctx, cancel := context.WithCancel(v1.WithWaitOptions(context.Background(), v1.WaitOptions{
+		InitialDelay: 10 * time.Millisecond,
+		MaxDelay:     20 * time.Millisecond,
+		Jitter:       5 * time.Millisecond,
+	}))
+	progress, results := client.BulkScheduleWithProgress(ctx, v1.BulkScheduleRequest{
+		Posts: []v1.BulkPost{
+			{Text: "First", Accounts: []string{"acc-001"}, ScheduledAt: time.Now().Add(time.Hour)},
+		},
+	})
+
+	// Stop reading after the first status; cancelling must still close both channels
+	<-progress
+	cancel()
+	for range progress {
+	}
+	result := <-results
+	require.ErrorIs(t, result.Err, context.Canceled)
+	assert.Empty(t, result.PostIDs)
+}
+
+func TestBulkScheduleWithProgressSubmitError(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	progress, results := client.BulkScheduleWithProgress(context.Background(), v1.BulkScheduleRequest{
+		Posts: []v1.BulkPost{
+			{Text: "Both", Accounts: []string{"acc-001"}, PublishNow: true, ScheduledAt: time.Now().Add(time.Hour)},
+		},
+	})
+
+	for range progress {
+		t.Fatal("no progress expected when submission fails")
+	}
+	result := <-results
+	require.Error(t, result.Err)
+	assert.Contains(t, result.Err.Error(), "scheduled time cannot be set when publishing now")
+}
+
+func TestBulkScheduleMixedTimeZones(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	at := time.Date(2030, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	for _, test := range []struct {
+		name        string
+		strict      bool
+		times       []time.Time
+		expectedErr string
+	}{
+		{
+			name:        "MixedZones",
+			strict:      true,
+			times:       []time.Time{at.In(newYork), at.In(newYork), at.In(tokyo)},
+			expectedErr: "validation failed for posts[2].scheduled_at: Scheduled time is in Asia/Tokyo but post 1 is in America/New_York; use UTC for every post",
+		},
+		{
+			name:   "SameZone",
+			strict: true,
+			times:  []time.Time{at, at.Add(time.Hour), at.Add(2 * time.Hour)},
+		},
+		{
+			name:   "MixedZonesNotStrict",
+			strict: false,
+			times:  []time.Time{at.In(newYork), at.In(tokyo)},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			client := server.ClientWithConfig(v1.Config{StrictBulkValidation: test.strict})
+
+			var req v1.BulkScheduleRequest
+			for _, scheduledAt := range test.times {
+				req.Posts = append(req.Posts, v1.BulkPost{
+					Text:        "Post",
+					Accounts:    []string{"acc-001"},
+					ScheduledAt: scheduledAt,
+				})
+			}
+
+			var resp v1.BulkScheduleResponse
+			err := client.BulkSchedule(context.Background(), req, &resp)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			var validationErr *v1.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, test.expectedErr, err.Error())
+		})
+	}
+}
+
+func TestBulkResultPosts(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	const jobID = "test-bulk-result"
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{ID: "post-001", Text: "First", State: "published", AccountID: "acc-001"},
+		{ID: "post-002", Text: "Second", State: "published", AccountID: "acc-002"},
+		{ID: "post-003", Text: "Third", State: "published", AccountID: "acc-003"},
+	})
+	server.SetJobStatus(jobID, "completed", 100, &v1.JobResult{
+		Success: true,
+		PostIDs: []string{"post-003", "post-001", "post-002"},
+	}, "")
+
+	posts, err := client.BulkResultPosts(context.Background(), jobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 3)
+	assert.Equal(t, "Third", posts[0].Text)
+	assert.Equal(t, "First", posts[1].Text)
+	assert.Equal(t, "Second", posts[2].Text)
+}
+
+func TestBulkResultPostsErrors(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	const jobID = "test-bulk-result"
+
+	t.Run("NotComplete", func(t *testing.T) {
+		server.Reset()
+		server.SetJobStatus(jobID, "working", 50, nil, "")
+
+		posts, err := client.BulkResultPosts(context.Background(), jobID)
+		require.EqualError(t, err, "job test-bulk-result is not complete: status working")
+		assert.Nil(t, posts)
+	})
+
+	t.Run("MissingPost", func(t *testing.T) {
+		server.Reset()
+		server.AddPosts([]v1.Post{{ID: "post-001", Text: "First", State: "published"}})
+		server.SetJobStatus(jobID, "completed", 100, &v1.JobResult{
+			Success: true,
+			PostIDs: []string{"post-001", "post-404"},
+		}, "")
+
+		posts, err := client.BulkResultPosts(context.Background(), jobID)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, v1.ErrNotFound)
+		assert.Contains(t, err.Error(), "post post-404")
+		assert.Nil(t, posts)
+	})
+
+	t.Run("UnknownJob", func(t *testing.T) {
+		server.Reset()
+
+		_, err := client.BulkResultPosts(context.Background(), "job-missing")
+		assert.ErrorIs(t, err, v1.ErrNotFound)
+	})
+}
@@ -2,6 +2,7 @@ package v1_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -123,4 +124,188 @@ func TestGetPostsByQuery(t *testing.T) {
 	assert.Equal(t, "1", page.Items[0].ID)
 	assert.Equal(t, "3", page.Items[1].ID)
 	assert.False(t, hasMore)
-}
\ No newline at end of file
+}
+
+func TestPendingApprovals(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	posts := []v1.Post{
+		{ID: "1", Text: "Awaiting review", State: v1.PostStatePendingApproval, AccountID: "acc-1"},
+		{ID: "2", Text: "Published post", State: "published", AccountID: "acc-1"},
+		{ID: "3", Text: "Also awaiting review", State: v1.PostStatePendingApproval, AccountID: "acc-2"},
+		{ID: "4", Text: "Draft post", State: "draft", AccountID: "acc-2"},
+	}
+	server.AddPosts(posts)
+
+	iter := client.PendingApprovals(context.Background())
+	require.NotNil(t, iter)
+
+	var page v1.Page[v1.Post]
+	hasMore := iter.Next(context.Background(), &page)
+	require.NoError(t, iter.Err())
+
+	require.Len(t, page.Items, 2)
+	assert.Equal(t, "1", page.Items[0].ID)
+	assert.Equal(t, "3", page.Items[1].ID)
+	for _, post := range page.Items {
+		assert.Equal(t, v1.PostStatePendingApproval, post.State)
+	}
+	assert.False(t, hasMore)
+}
+
+func TestMemberQueue(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{ID: "1", Text: "Alice scheduled", State: v1.PostStateScheduled, AccountID: "acc-1", User: v1.User{ID: "member-alice"}},
+		{ID: "2", Text: "Bob scheduled", State: v1.PostStateScheduled, AccountID: "acc-1", User: v1.User{ID: "member-bob"}},
+		{ID: "3", Text: "Alice draft", State: v1.PostStateDraft, AccountID: "acc-2", User: v1.User{ID: "member-alice"}},
+		{ID: "4", Text: "Alice scheduled elsewhere", State: v1.PostStateScheduled, AccountID: "acc-2", User: v1.User{ID: "member-alice"}},
+		{ID: "5", Text: "Alice published", State: v1.PostStatePublished, AccountID: "acc-1", User: v1.User{ID: "member-alice"}},
+	})
+
+	posts, err := v1.Collect(context.Background(), client.MemberQueue(context.Background(), "member-alice"))
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+	assert.Equal(t, "1", posts[0].ID)
+	assert.Equal(t, "4", posts[1].ID)
+
+	for _, memberID := range []string{"", "member alice", "../admin"} {
+		_, err := v1.Collect(context.Background(), client.MemberQueue(context.Background(), memberID))
+		require.ErrorContains(t, err, "invalid member ID")
+	}
+}
+
+func TestExportPost(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{
+			ID:       "export-1",
+			Text:     "Published post",
+			State:    v1.PostStatePublished,
+			HasMedia: true,
+			Media:    []v1.Media{{URL: "https://example.com/image.jpg", Type: "image"}},
+		},
+		{ID: "export-2", Text: "Scheduled post", State: v1.PostStateScheduled},
+	})
+	server.SetPostAnalytics("export-1", v1.PostAnalytics{Impressions: 1200, Reach: 900, Likes: 42, Shares: 7})
+
+	export, err := client.ExportPost(context.Background(), "export-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Published post", export.Post.Text)
+	assert.Equal(t, []v1.Media{{URL: "https://example.com/image.jpg", Type: "image"}}, export.Media)
+	require.NotNil(t, export.Analytics)
+	assert.Equal(t, v1.PostAnalytics{Impressions: 1200, Reach: 900, Likes: 42, Shares: 7}, *export.Analytics)
+
+	// Posts that are not published are exported without analytics
+	export, err = client.ExportPost(context.Background(), "export-2")
+	require.NoError(t, err)
+	assert.Equal(t, "Scheduled post", export.Post.Text)
+	assert.Empty(t, export.Media)
+	assert.Nil(t, export.Analytics)
+}
+
+func TestExportPostErrors(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{{ID: "export-1", Text: "Published post", State: v1.PostStatePublished}})
+
+	_, err := client.ExportPost(context.Background(), "missing-1")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, v1.ErrNotFound)
+
+	// Cancelling once the post has been fetched stops the export before analytics are requested
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cancelling := server.ClientWithConfig(v1.Config{
+		OnResponse: func(info v1.ResponseInfo) { cancel() },
+	})
+	_, err = cancelling.ExportPost(ctx, "export-1")
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, server.CallCount("GET", "/api/v1/posts/export-1"))
+	assert.Equal(t, 0, server.CallCount("GET", "/api/v1/posts/export-1/analytics"))
+
+	_, err = client.GetPostAnalytics(context.Background(), "../admin")
+	require.Error(t, err)
+	require.ErrorContains(t, err, "invalid post ID")
+}
+
+func TestPostStateCounts(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	var posts []v1.Post
+	for i, state := range []string{"draft", "scheduled", "scheduled", "published", "published", "published"} {
+		posts = append(posts, v1.Post{
+			ID:        fmt.Sprintf("post-%03d", i+1),
+			Text:      "Post",
+			State:     state,
+			AccountID: fmt.Sprintf("acc-%03d", i%2+1),
+		})
+	}
+
+	t.Run("AllPosts", func(t *testing.T) {
+		server.Reset()
+		server.AddPosts(posts)
+
+		counts, err := client.PostStateCounts(context.Background(), v1.ListPostsRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"draft": 1, "scheduled": 2, "published": 3}, counts)
+	})
+
+	t.Run("Filtered", func(t *testing.T) {
+		server.Reset()
+		server.AddPosts(posts)
+
+		counts, err := client.PostStateCounts(context.Background(), v1.ListPostsRequest{
+			AccountIDs: []string{"acc-001"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"draft": 1, "scheduled": 1, "published": 1}, counts)
+	})
+
+	t.Run("PartialOnError", func(t *testing.T) {
+		server.Reset()
+		var many []v1.Post
+		for i := range 15 {
+			many = append(many, v1.Post{ID: fmt.Sprintf("post-%03d", i+1), Text: "Post", State: "scheduled"})
+		}
+		server.AddPosts(many)
+		server.SetErrorResponse("GET", "/api/v1/posts", 2, 500, v1.ErrorResponse{Error: "internal", Message: "Boom"}, nil)
+
+		counts, err := client.PostStateCounts(context.Background(), v1.ListPostsRequest{})
+		require.Error(t, err)
+		assert.Equal(t, map[string]int{"scheduled": 10}, counts)
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		server.Reset()
+		server.AddPosts(posts)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		counts, err := client.PostStateCounts(ctx, v1.ListPostsRequest{})
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Empty(t, counts)
+	})
+}
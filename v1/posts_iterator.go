@@ -33,6 +33,12 @@ func (f *PostPageFetcher) FetchPage(ctx context.Context, pageNum int) (*Page[Pos
 	if !request.To.IsZero() {
 		params.Set("to", request.To.Format(time.RFC3339))
 	}
+	if !request.CreatedFrom.IsZero() {
+		params.Set("created_from", request.CreatedFrom.Format(time.RFC3339))
+	}
+	if !request.CreatedTo.IsZero() {
+		params.Set("created_to", request.CreatedTo.Format(time.RFC3339))
+	}
 	if pageNum > 0 {
 		params.Set("page", strconv.Itoa(pageNum))
 	}
@@ -48,10 +54,24 @@ func (f *PostPageFetcher) FetchPage(ctx context.Context, pageNum int) (*Page[Pos
 	if request.MemberID != "" {
 		params.Set("member_id", request.MemberID)
 	}
+	if request.HasMedia != nil {
+		params.Set("has_media", strconv.FormatBool(*request.HasMedia))
+	}
+	if request.IncludeTrashed {
+		params.Set("include_trashed", "true")
+	}
+	for _, field := range request.Fields {
+		params.Add("fields[]", field)
+	}
+
+	path := "posts?" + params.Encode()
+	if f.client.config.TolerantDecoding {
+		return fetchTolerantPage[Post](ctx, f.client, path, "posts")
+	}
 
 	// Make API call to get posts
 	var response ListPostsResponse
-	err := f.client.do(ctx, "GET", "posts?"+params.Encode(), nil, &response)
+	err := f.client.do(ctx, "GET", path, nil, &response)
 	if err != nil {
 		return nil, err
 	}
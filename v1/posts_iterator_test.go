@@ -21,7 +21,7 @@ func TestListPostsIterator(t *testing.T) {
 	posts := make([]v1.Post, totalPosts)
 	for i := 0; i < totalPosts; i++ {
 		posts[i] = v1.Post{
-			ID:          fmt.Sprintf("post-%d", i+1),
+			ID:          fmt.Sprintf("post-%02d", i+1),
 			Text:        fmt.Sprintf("Post content %d", i+1),
 			State:       "published",
 			Type:        "regular",
@@ -95,7 +95,7 @@ func TestPostIteratorPagination(t *testing.T) {
 	posts := make([]v1.Post, totalPosts)
 	for i := 0; i < totalPosts; i++ {
 		posts[i] = v1.Post{
-			ID:        fmt.Sprintf("iter-post-%d", i+1),
+			ID:        fmt.Sprintf("iter-post-%02d", i+1),
 			Text:      fmt.Sprintf("Iterator test post %d", i+1),
 			State:     "scheduled",
 			AccountID: "test-account",
@@ -115,7 +115,7 @@ func TestPostIteratorPagination(t *testing.T) {
 	assert.True(t, hasMore)
 	assert.Equal(t, 1, page1.Page)
 	assert.Len(t, page1.Items, 10)
-	assert.Equal(t, "iter-post-1", page1.Items[0].ID)
+	assert.Equal(t, "iter-post-01", page1.Items[0].ID)
 	assert.Equal(t, "iter-post-10", page1.Items[9].ID)
 
 	// Page 2
@@ -157,6 +157,161 @@ func TestPostIteratorError(t *testing.T) {
 	require.ErrorContains(t, err, "Internal Server Error")
 }
 
+func TestCollectPosts(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	posts := make([]v1.Post, 35)
+	for i := range posts {
+		posts[i] = v1.Post{ID: fmt.Sprintf("post-%02d", i+1), Text: "Post", State: "scheduled"}
+	}
+
+	server.Reset()
+	server.AddPosts(posts)
+
+	collected, err := v1.Collect(context.Background(), client.ListPosts(context.Background(), v1.ListPostsRequest{}))
+	require.NoError(t, err)
+	require.Len(t, collected, 35)
+	for i, post := range collected {
+		assert.Equal(t, posts[i].ID, post.ID)
+	}
+	assert.Equal(t, 4, server.CallCount("GET", "/api/v1/posts"))
+}
+
+func TestCollectPostsError(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	posts := make([]v1.Post, 25)
+	for i := range posts {
+		posts[i] = v1.Post{ID: fmt.Sprintf("post-%02d", i+1), Text: "Post", State: "scheduled"}
+	}
+
+	server.Reset()
+	server.AddPosts(posts)
+	server.SetErrorResponse("GET", "/api/v1/posts", 2, 500, map[string]string{"error": "Internal Server Error"}, nil)
+
+	collected, err := v1.Collect(context.Background(), client.ListPosts(context.Background(), v1.ListPostsRequest{}))
+	require.ErrorContains(t, err, "Internal Server Error")
+	assert.Nil(t, collected)
+	assert.Equal(t, 2, server.CallCount("GET", "/api/v1/posts"))
+}
+
+func TestCollectPostsContextCancelled(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{{ID: "post-01", Text: "Post", State: "scheduled"}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	collected, err := v1.Collect(ctx, client.ListPosts(ctx, v1.ListPostsRequest{}))
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, collected)
+	assert.Equal(t, 0, server.CallCount("GET", "/api/v1/posts"))
+}
+
+func TestPostIteratorTolerantDecoding(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	// The second post carries a scheduled_at that is not a valid time
+	body := []byte(`{
+		"posts": [
+			{"id": "post-1", "text": "First", "scheduled_at": "2025-01-06T10:00:00Z"},
+			{"id": "post-2", "text": "Broken", "scheduled_at": "next tuesday"},
+			{"id": "post-3", "text": "Third"},
+			{"id": "post-4", "text": "Fourth"}
+		],
+		"total": 4,
+		"page": 1,
+		"per_page": 10,
+		"total_pages": 1
+	}`)
+
+	server.Reset()
+	server.SetResponse("GET", "/api/v1/posts", 200, body)
+
+	// Without tolerant decoding the whole page fails
+	iterator := server.Client().ListPosts(context.Background(), v1.ListPostsRequest{})
+	var page v1.Page[v1.Post]
+	assert.False(t, iterator.Next(context.Background(), &page))
+	require.Error(t, iterator.Err())
+
+	client := server.ClientWithConfig(v1.Config{TolerantDecoding: true})
+	iterator = client.ListPosts(context.Background(), v1.ListPostsRequest{})
+	page = v1.Page[v1.Post]{}
+	assert.False(t, iterator.Next(context.Background(), &page))
+	require.NoError(t, iterator.Err())
+
+	require.Len(t, page.Items, 3)
+	assert.Equal(t, "post-1", page.Items[0].ID)
+	assert.Equal(t, "post-3", page.Items[1].ID)
+	assert.Equal(t, "post-4", page.Items[2].ID)
+	assert.Equal(t, 4, page.Total)
+	assert.Equal(t, 1, page.TotalPages)
+
+	require.Len(t, page.ItemErrors, 1)
+	assert.ErrorContains(t, page.ItemErrors[0], "item 2")
+	assert.ErrorContains(t, page.ItemErrors[0], "next tuesday")
+}
+
+func TestPostIteratorStatusCode(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	var posts []v1.Post
+	for i := 1; i <= 15; i++ {
+		posts = append(posts, v1.Post{ID: fmt.Sprintf("post-%02d", i), State: "published"})
+	}
+
+	server.Reset()
+	server.AddPosts(posts)
+	// Fail from the second request onwards so page 2 returns a 500
+	server.SetErrorResponse("GET", "/api/v1/posts", 2, 500, map[string]string{"error": "Internal Server Error"}, nil)
+
+	iter, ok := client.ListPosts(context.Background(), v1.ListPostsRequest{}).(*v1.GenericIterator[v1.Post])
+	require.True(t, ok)
+
+	var page v1.Page[v1.Post]
+	assert.True(t, iter.Next(context.Background(), &page))
+	require.NoError(t, iter.Err())
+	assert.Equal(t, 0, iter.StatusCode())
+
+	assert.False(t, iter.Next(context.Background(), &page))
+	require.Error(t, iter.Err())
+	assert.Equal(t, 500, iter.StatusCode())
+}
+
+func TestPostIteratorStatusCodeNonHTTP(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+	server.Reset()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	iter, ok := client.ListPosts(ctx, v1.ListPostsRequest{}).(*v1.GenericIterator[v1.Post])
+	require.True(t, ok)
+
+	var page v1.Page[v1.Post]
+	assert.False(t, iter.Next(ctx, &page))
+	require.Error(t, iter.Err())
+	assert.Equal(t, 0, iter.StatusCode())
+}
+
 func TestPostIteratorContext(t *testing.T) {
 	server := v1.SpawnMockServer()
 	defer func() { _ = server.Stop() }()
@@ -327,6 +482,242 @@ func TestPostIteratorWithFilters(t *testing.T) {
 	assert.Len(t, page.Items, 0)
 }
 
+func TestPostIteratorHasMediaFilter(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	posts := []v1.Post{
+		{ID: "m1", Text: "With media 1", State: "published", HasMedia: true},
+		{ID: "m2", Text: "Without media 1", State: "published", HasMedia: false},
+		{ID: "m3", Text: "With media 2", State: "published", HasMedia: true},
+		{ID: "m4", Text: "Without media 2", State: "published", HasMedia: false},
+		{ID: "m5", Text: "Without media 3", State: "published", HasMedia: false},
+	}
+
+	withMedia := true
+	withoutMedia := false
+
+	for _, test := range []struct {
+		name     string
+		hasMedia *bool
+		wantIDs  []string
+	}{
+		{
+			name:     "WithMedia",
+			hasMedia: &withMedia,
+			wantIDs:  []string{"m1", "m3"},
+		},
+		{
+			name:     "WithoutMedia",
+			hasMedia: &withoutMedia,
+			wantIDs:  []string{"m2", "m4", "m5"},
+		},
+		{
+			name:     "Unset",
+			hasMedia: nil,
+			wantIDs:  []string{"m1", "m2", "m3", "m4", "m5"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.AddPosts(posts)
+
+			iterator := client.ListPosts(context.Background(), v1.ListPostsRequest{
+				HasMedia: test.hasMedia,
+			})
+
+			var page v1.Page[v1.Post]
+			hasMore := iterator.Next(context.Background(), &page)
+			require.NoError(t, iterator.Err())
+			assert.False(t, hasMore)
+
+			var ids []string
+			for _, post := range page.Items {
+				ids = append(ids, post.ID)
+			}
+			assert.Equal(t, test.wantIDs, ids)
+		})
+	}
+}
+
+func TestPostIteratorCreatedDateFilter(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	base := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	posts := []v1.Post{
+		{ID: "c1", State: "scheduled", CreatedAt: base.Add(-72 * time.Hour), ScheduledAt: base.Add(24 * time.Hour)},
+		{ID: "c2", State: "scheduled", CreatedAt: base.Add(-24 * time.Hour), ScheduledAt: base.Add(96 * time.Hour)},
+		{ID: "c3", State: "scheduled", CreatedAt: base, ScheduledAt: base.Add(48 * time.Hour)},
+		{ID: "c4", State: "scheduled", CreatedAt: base.Add(24 * time.Hour), ScheduledAt: base.Add(72 * time.Hour)},
+	}
+
+	for _, test := range []struct {
+		name    string
+		request v1.ListPostsRequest
+		wantIDs []string
+	}{
+		{
+			name: "CreatedWindow",
+			request: v1.ListPostsRequest{
+				CreatedFrom: base.Add(-48 * time.Hour),
+				CreatedTo:   base,
+			},
+			wantIDs: []string{"c2", "c3"},
+		},
+		{
+			name: "CreatedFromOnly",
+			request: v1.ListPostsRequest{
+				CreatedFrom: base,
+			},
+			wantIDs: []string{"c3", "c4"},
+		},
+		{
+			name: "CreatedAndScheduledWindows",
+			request: v1.ListPostsRequest{
+				CreatedFrom: base.Add(-48 * time.Hour),
+				CreatedTo:   base.Add(48 * time.Hour),
+				From:        base.Add(60 * time.Hour),
+				To:          base.Add(120 * time.Hour),
+			},
+			wantIDs: []string{"c2", "c4"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.AddPosts(posts)
+
+			iterator := client.ListPosts(context.Background(), test.request)
+
+			var page v1.Page[v1.Post]
+			hasMore := iterator.Next(context.Background(), &page)
+			require.NoError(t, iterator.Err())
+			assert.False(t, hasMore)
+
+			var ids []string
+			for _, post := range page.Items {
+				ids = append(ids, post.ID)
+			}
+			assert.Equal(t, test.wantIDs, ids)
+		})
+	}
+}
+
+func TestListPostsPage(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	var posts []v1.Post
+	for i := 1; i <= 25; i++ {
+		state := "published"
+		if i%2 == 0 {
+			state = "scheduled"
+		}
+		posts = append(posts, v1.Post{
+			ID:    fmt.Sprintf("post-%02d", i),
+			Text:  fmt.Sprintf("Post %d", i),
+			State: state,
+		})
+	}
+
+	server.Reset()
+	server.AddPosts(posts)
+
+	page, err := client.ListPostsPage(context.Background(), v1.ListPostsRequest{}, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 25, page.Total)
+	assert.Equal(t, 3, page.Page)
+	assert.Equal(t, 3, page.TotalPages)
+	require.Len(t, page.Items, 5)
+	assert.Equal(t, "post-21", page.Items[0].ID)
+
+	page, err = client.ListPostsPage(context.Background(), v1.ListPostsRequest{State: "scheduled"}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 12, page.Total)
+	require.Len(t, page.Items, 2)
+	assert.Equal(t, "post-22", page.Items[0].ID)
+	assert.Equal(t, "post-24", page.Items[1].ID)
+
+	_, err = client.ListPostsPage(context.Background(), v1.ListPostsRequest{}, -1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "page must be at least 1")
+}
+
+func TestPostIteratorFields(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{
+			ID:          "post-01",
+			Text:        "First post",
+			State:       "published",
+			AccountID:   "account-1",
+			Network:     "facebook",
+			HasMedia:    true,
+			ScheduledAt: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        "post-02",
+			Text:      "Second post",
+			State:     "scheduled",
+			AccountID: "account-2",
+			Network:   "twitter",
+		},
+	})
+
+	for _, test := range []struct {
+		name   string
+		fields []string
+		want   []v1.Post
+	}{
+		{
+			name:   "Subset",
+			fields: []string{"id", "state"},
+			want: []v1.Post{
+				{ID: "post-01", State: "published"},
+				{ID: "post-02", State: "scheduled"},
+			},
+		},
+		{
+			name:   "UnknownFieldIgnored",
+			fields: []string{"id", "network", "unknown"},
+			want: []v1.Post{
+				{ID: "post-01", Network: "facebook"},
+				{ID: "post-02", Network: "twitter"},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			iter := client.ListPosts(context.Background(), v1.ListPostsRequest{Fields: test.fields})
+
+			var page v1.Page[v1.Post]
+			iter.Next(context.Background(), &page)
+			require.NoError(t, iter.Err())
+			assert.Equal(t, test.want, page.Items)
+		})
+	}
+
+	// Without a selector all fields are returned
+	iter := client.ListPosts(context.Background(), v1.ListPostsRequest{})
+
+	var page v1.Page[v1.Post]
+	iter.Next(context.Background(), &page)
+	require.NoError(t, iter.Err())
+	require.Len(t, page.Items, 2)
+	assert.Equal(t, "First post", page.Items[0].Text)
+	assert.True(t, page.Items[0].HasMedia)
+}
+
 func TestPostIteratorLazyLoading(t *testing.T) {
 	server := v1.SpawnMockServer()
 	defer func() { _ = server.Stop() }()
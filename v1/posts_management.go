@@ -1,6 +1,9 @@
 package v1
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // GetPostRequest represents request for single post
 type GetPostRequest struct {
@@ -12,14 +15,81 @@ type GetPostResponse struct {
 	Post
 }
 
-// UpdatePostRequest represents post update request
+// PostExport combines everything known about a post for audits. Analytics is nil
+// for posts that have not been published.
+type PostExport struct {
+	Post      Post           `json:"post"`
+	Media     []Media        `json:"media"`
+	Analytics *PostAnalytics `json:"analytics,omitempty"`
+}
+
+// MovePostToWorkspaceRequest represents the body of a request moving a post to
+// another workspace
+type MovePostToWorkspaceRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+}
+
+// GetPostCommentsRequest represents request for the comments on a post
+type GetPostCommentsRequest struct {
+	PostID string
+}
+
+// GetPostCommentsResponse represents paginated post comments response
+type GetPostCommentsResponse struct {
+	Comments   []Comment `json:"comments"`
+	Total      int       `json:"total"`
+	Page       int       `json:"page"`
+	PerPage    int       `json:"per_page"`
+	TotalPages int       `json:"total_pages"`
+}
+
+// ReplyToCommentRequest represents a reply to a comment on a post
+type ReplyToCommentRequest struct {
+	PostID    string `json:"-"`
+	CommentID string `json:"-"`
+	Text      string `json:"text"`
+}
+
+// ReplyToCommentResponse contains the created reply
+type ReplyToCommentResponse struct {
+	Comment
+}
+
+// UpdatePostRequest represents post update request. A nil Media leaves the
+// media unchanged while an empty, non-nil Media removes all media. A nil Accounts
+// leaves the target account unchanged; a post always needs an account, so an
+// empty, non-nil Accounts is rejected.
 type UpdatePostRequest struct {
 	ScheduledAt time.Time `json:"scheduled_at,omitempty"`
-	Media       []Media   `json:"media,omitempty"`
+	Media       []Media   `json:"media,omitzero"`
+	Accounts    []string  `json:"accounts,omitzero"`
 	Text        string    `json:"text,omitempty"`
+	State       string    `json:"state,omitempty"` // only draft to scheduled is supported
 	PostID      string    `json:"-"`
 }
 
+// DiffPost returns the minimal UpdatePostRequest that turns current into desired.
+// Only text, scheduled time and media are compared; unchanged fields are left zero
+// so they are omitted from the update. Media removed in desired is sent as an empty
+// list to clear it. Text and scheduled time cannot be cleared, so a zero value in
+// desired leaves them unchanged.
+func DiffPost(current, desired Post) UpdatePostRequest {
+	req := UpdatePostRequest{PostID: current.ID}
+	if desired.Text != current.Text {
+		req.Text = desired.Text
+	}
+	if !desired.ScheduledAt.Equal(current.ScheduledAt) {
+		req.ScheduledAt = desired.ScheduledAt
+	}
+	if !slices.Equal(desired.Media, current.Media) {
+		req.Media = desired.Media
+		if req.Media == nil {
+			req.Media = []Media{}
+		}
+	}
+	return req
+}
+
 // UpdatePostResponse represents post update response
 type UpdatePostResponse struct {
 	Post
@@ -34,4 +104,11 @@ type DeletePostRequest struct {
 type DeletePostResponse struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
-}
\ No newline at end of file
+}
+
+// UpdateLabelsRequest represents a bulk label change across posts
+type UpdateLabelsRequest struct {
+	PostIDs []string `json:"post_ids"`
+	Labels  []string `json:"labels"`
+	Action  string   `json:"action"` // add or remove
+}
@@ -2,6 +2,7 @@ package v1_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -123,6 +124,514 @@ func TestDeletePost(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestReschedulePostBy(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	scheduled := now.Add(time.Hour)
+	client := server.ClientWithConfig(v1.Config{
+		Now: func() time.Time { return now },
+	})
+
+	for _, test := range []struct {
+		name    string
+		post    v1.Post
+		delta   time.Duration
+		want    time.Time
+		wantErr string
+	}{
+		{
+			name:  "Later",
+			post:  v1.Post{ID: "post-1", State: "scheduled", ScheduledAt: scheduled},
+			delta: 30 * time.Minute,
+			want:  scheduled.Add(30 * time.Minute),
+		},
+		{
+			name:  "Earlier",
+			post:  v1.Post{ID: "post-1", State: "scheduled", ScheduledAt: scheduled},
+			delta: -30 * time.Minute,
+			want:  scheduled.Add(-30 * time.Minute),
+		},
+		{
+			name:    "IntoThePast",
+			post:    v1.Post{ID: "post-1", State: "scheduled", ScheduledAt: scheduled},
+			delta:   -2 * time.Hour,
+			want:    scheduled,
+			wantErr: "scheduled time must be in the future",
+		},
+		{
+			name:    "NotScheduled",
+			post:    v1.Post{ID: "post-1", State: "draft"},
+			delta:   time.Hour,
+			wantErr: "post post-1 has no scheduled time",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.AddPosts([]v1.Post{test.post})
+
+			err := client.ReschedulePostBy(context.Background(), "post-1", test.delta)
+			if test.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var resp v1.GetPostResponse
+			err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "post-1"}, &resp)
+			require.NoError(t, err)
+			assert.True(t, test.want.Equal(resp.ScheduledAt))
+		})
+	}
+}
+
+func TestShiftAccountSchedule(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	client := server.ClientWithConfig(v1.Config{
+		Now: func() time.Time { return now },
+	})
+
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{ID: "post-01", AccountID: "account-1", State: "scheduled", ScheduledAt: now.Add(3 * time.Hour)},
+		{ID: "post-02", AccountID: "account-1", State: "scheduled", ScheduledAt: now.Add(time.Hour)},
+		{ID: "post-03", AccountID: "account-1", State: "scheduled", ScheduledAt: now.Add(5 * time.Hour)},
+		{ID: "post-04", AccountID: "account-2", State: "scheduled", ScheduledAt: now.Add(3 * time.Hour)},
+		{ID: "post-05", AccountID: "account-1", State: "draft"},
+	})
+
+	shifted, err := client.ShiftAccountSchedule(context.Background(), "account-1", -2*time.Hour)
+	require.NoError(t, err)
+
+	// post-02 would land in the past so it is skipped
+	assert.Equal(t, []string{"post-01", "post-03"}, shifted)
+
+	for _, test := range []struct {
+		postID string
+		want   time.Time
+	}{
+		{"post-01", now.Add(time.Hour)},
+		{"post-02", now.Add(time.Hour)},
+		{"post-03", now.Add(3 * time.Hour)},
+		{"post-04", now.Add(3 * time.Hour)},
+	} {
+		var resp v1.GetPostResponse
+		require.NoError(t, client.GetPost(context.Background(), v1.GetPostRequest{PostID: test.postID}, &resp))
+		assert.True(t, test.want.Equal(resp.ScheduledAt))
+	}
+}
+
+func TestShiftAccountScheduleRateLimited(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.ClientWithConfig(v1.Config{
+		Retry: v1.RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{ID: "post-01", AccountID: "account-1", State: "scheduled", ScheduledAt: time.Now().Add(3 * time.Hour)},
+		{ID: "post-02", AccountID: "account-1", State: "scheduled", ScheduledAt: time.Now().Add(4 * time.Hour)},
+	})
+	server.SetTransientError("PATCH", "/api/v1/posts/post-01", 2, 429)
+	server.SetErrorResponse("PATCH", "/api/v1/posts/post-02", 0, 429,
+		map[string]string{"error": "rate_limit_exceeded"}, nil)
+
+	// post-01 recovers within the retry budget, post-02 never does
+	shifted, err := client.ShiftAccountSchedule(context.Background(), "account-1", time.Hour)
+	var rateLimitErr *v1.RateLimitError
+	require.ErrorAs(t, err, &rateLimitErr)
+	assert.Equal(t, []string{"post-01"}, shifted)
+	assert.Equal(t, 3, server.CallCount("PATCH", "/api/v1/posts/post-01"))
+	assert.Equal(t, 3, server.CallCount("PATCH", "/api/v1/posts/post-02"))
+}
+
+func TestShiftAccountScheduleNoPosts(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	shifted, err := client.ShiftAccountSchedule(context.Background(), "account-1", time.Hour)
+	require.NoError(t, err)
+	assert.Empty(t, shifted)
+
+	_, err = client.ShiftAccountSchedule(context.Background(), "", time.Hour)
+	require.EqualError(t, err, "account ID cannot be empty")
+}
+
+func TestPromoteDraft(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{ID: "draft-1", Text: "Draft post", State: v1.PostStateDraft},
+		{ID: "published-1", Text: "Published post", State: "published"},
+	})
+
+	scheduledAt := time.Now().Add(2 * time.Hour).UTC().Truncate(time.Second)
+	err := client.PromoteDraft(context.Background(), "draft-1", scheduledAt)
+	require.NoError(t, err)
+
+	var resp v1.GetPostResponse
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "draft-1"}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, v1.PostStateScheduled, resp.State)
+	assert.True(t, scheduledAt.Equal(resp.ScheduledAt))
+	assert.Equal(t, "Draft post", resp.Text)
+
+	// Only drafts can be promoted
+	for _, postID := range []string{"published-1", "draft-1"} {
+		err = client.PromoteDraft(context.Background(), postID, scheduledAt)
+		require.Error(t, err)
+
+		var apiErr *v1.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, 409, apiErr.StatusCode)
+	}
+
+	// The scheduled time must be in the future
+	server.AddPosts([]v1.Post{{ID: "draft-2", State: v1.PostStateDraft}})
+	err = client.PromoteDraft(context.Background(), "draft-2", time.Now().Add(-time.Hour))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "scheduled time must be in the future")
+}
+
+func TestDiffPost(t *testing.T) {
+	scheduled := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	image := []v1.Media{{URL: "https://example.com/image.jpg", Type: "image"}}
+	current := v1.Post{
+		ID:          "post-diff",
+		Text:        "Original text",
+		ScheduledAt: scheduled,
+		Media:       image,
+		HasMedia:    true,
+	}
+
+	for _, test := range []struct {
+		name    string
+		desired v1.Post
+		want    v1.UpdatePostRequest
+	}{
+		{
+			name:    "Unchanged",
+			desired: current,
+			want:    v1.UpdatePostRequest{PostID: "post-diff"},
+		},
+		{
+			name: "ChangedText",
+			desired: v1.Post{
+				Text:        "New text",
+				ScheduledAt: scheduled,
+				Media:       image,
+			},
+			want: v1.UpdatePostRequest{PostID: "post-diff", Text: "New text"},
+		},
+		{
+			name: "ChangedScheduledAtAndMedia",
+			desired: v1.Post{
+				Text:        "Original text",
+				ScheduledAt: scheduled.Add(time.Hour),
+				Media:       []v1.Media{{URL: "https://example.com/video.mp4", Type: "video"}},
+			},
+			want: v1.UpdatePostRequest{
+				PostID:      "post-diff",
+				ScheduledAt: scheduled.Add(time.Hour),
+				Media:       []v1.Media{{URL: "https://example.com/video.mp4", Type: "video"}},
+			},
+		},
+		{
+			name: "ClearedMedia",
+			desired: v1.Post{
+				Text:        "Original text",
+				ScheduledAt: scheduled,
+			},
+			want: v1.UpdatePostRequest{PostID: "post-diff", Media: []v1.Media{}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, v1.DiffPost(current, test.desired))
+		})
+	}
+}
+
+func TestDiffPostClearsMedia(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	current := v1.Post{
+		ID:       "post-diff",
+		Text:     "Original text",
+		State:    "scheduled",
+		Media:    []v1.Media{{URL: "https://example.com/image.jpg", Type: "image"}},
+		HasMedia: true,
+	}
+
+	server.Reset()
+	server.AddPosts([]v1.Post{current})
+
+	desired := current
+	desired.Media = nil
+
+	var resp v1.UpdatePostResponse
+	err := client.UpdatePost(context.Background(), v1.DiffPost(current, desired), &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "Original text", resp.Text)
+	assert.False(t, resp.HasMedia)
+	assert.Empty(t, resp.Media)
+
+	// An unchanged diff leaves the post untouched
+	err = client.UpdatePost(context.Background(), v1.DiffPost(resp.Post, resp.Post), &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "Original text", resp.Text)
+	assert.False(t, resp.HasMedia)
+}
+
+func TestDeleteAndRestorePost(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{ID: "keep-1", Text: "Kept post", State: "scheduled"},
+		{ID: "trash-1", Text: "Trashed post", State: "scheduled"},
+	})
+
+	var deleteResp v1.DeletePostResponse
+	err := client.DeletePost(context.Background(), v1.DeletePostRequest{
+		PostID: "trash-1",
+	}, &deleteResp)
+	require.NoError(t, err)
+
+	// Listing without trashed posts hides the deleted post
+	iterator := client.ListPosts(context.Background(), v1.ListPostsRequest{})
+	var page v1.Page[v1.Post]
+	iterator.Next(context.Background(), &page)
+	require.NoError(t, iterator.Err())
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, "keep-1", page.Items[0].ID)
+
+	// Listing with trashed posts includes it in the trashed state
+	iterator = client.ListPosts(context.Background(), v1.ListPostsRequest{
+		IncludeTrashed: true,
+	})
+	page = v1.Page[v1.Post]{}
+	iterator.Next(context.Background(), &page)
+	require.NoError(t, iterator.Err())
+	require.Len(t, page.Items, 2)
+	assert.Equal(t, "trash-1", page.Items[1].ID)
+	assert.Equal(t, v1.PostStateTrashed, page.Items[1].State)
+
+	// Filtering on the trashed state returns only trashed posts
+	iterator = client.ListPosts(context.Background(), v1.ListPostsRequest{
+		State:          v1.PostStateTrashed,
+		IncludeTrashed: true,
+	})
+	page = v1.Page[v1.Post]{}
+	iterator.Next(context.Background(), &page)
+	require.NoError(t, iterator.Err())
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, "trash-1", page.Items[0].ID)
+
+	// Restoring brings the post back with its original state
+	err = client.RestorePost(context.Background(), "trash-1")
+	require.NoError(t, err)
+
+	var getResp v1.GetPostResponse
+	err = client.GetPost(context.Background(), v1.GetPostRequest{
+		PostID: "trash-1",
+	}, &getResp)
+	require.NoError(t, err)
+	assert.Equal(t, "scheduled", getResp.State)
+
+	iterator = client.ListPosts(context.Background(), v1.ListPostsRequest{
+		State:          v1.PostStateTrashed,
+		IncludeTrashed: true,
+	})
+	page = v1.Page[v1.Post]{}
+	iterator.Next(context.Background(), &page)
+	require.NoError(t, iterator.Err())
+	assert.Empty(t, page.Items)
+}
+
+func TestRestorePostNotInTrash(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{{ID: "live-1", Text: "Live post", State: "scheduled"}})
+
+	err := client.RestorePost(context.Background(), "live-1")
+	require.Error(t, err)
+	require.ErrorContains(t, err, "Post not found in trash")
+
+	err = client.RestorePost(context.Background(), "../admin")
+	require.Error(t, err)
+	require.ErrorContains(t, err, "invalid post ID")
+}
+
+func TestPinPost(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{{ID: "pin-1", Text: "Pin me", State: "published"}})
+
+	err := client.PinPost(context.Background(), "pin-1")
+	require.NoError(t, err)
+
+	var resp v1.GetPostResponse
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "pin-1"}, &resp)
+	require.NoError(t, err)
+	assert.True(t, resp.Pinned)
+
+	err = client.UnpinPost(context.Background(), "pin-1")
+	require.NoError(t, err)
+
+	resp = v1.GetPostResponse{}
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "pin-1"}, &resp)
+	require.NoError(t, err)
+	assert.False(t, resp.Pinned)
+}
+
+func TestPinPostErrors(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	err := client.PinPost(context.Background(), "missing-1")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, v1.ErrNotFound)
+
+	err = client.UnpinPost(context.Background(), "missing-1")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, v1.ErrNotFound)
+
+	err = client.PinPost(context.Background(), "../admin")
+	require.Error(t, err)
+	require.ErrorContains(t, err, "invalid post ID")
+}
+
+func TestAddAndRemoveLabels(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{ID: "label-1", Text: "First", State: "scheduled"},
+		{ID: "label-2", Text: "Second", State: "scheduled", Labels: []string{"existing"}},
+		{ID: "label-3", Text: "Third", State: "scheduled"},
+	})
+
+	err := client.AddLabels(context.Background(), []string{"label-1", "label-2"}, []string{"campaign", "existing"})
+	require.NoError(t, err)
+
+	var resp v1.GetPostResponse
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "label-1"}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"campaign", "existing"}, resp.Labels)
+
+	resp = v1.GetPostResponse{}
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "label-2"}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"existing", "campaign"}, resp.Labels)
+
+	resp = v1.GetPostResponse{}
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "label-3"}, &resp)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Labels)
+
+	err = client.RemoveLabels(context.Background(), []string{"label-1", "label-2"}, []string{"existing"})
+	require.NoError(t, err)
+
+	resp = v1.GetPostResponse{}
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "label-1"}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"campaign"}, resp.Labels)
+
+	resp = v1.GetPostResponse{}
+	err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "label-2"}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"campaign"}, resp.Labels)
+}
+
+func TestLabelsValidation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name    string
+		postIDs []string
+		labels  []string
+		wantErr string
+	}{
+		{
+			name:    "NoPostIDs",
+			postIDs: nil,
+			labels:  []string{"campaign"},
+			wantErr: "at least one post ID is required",
+		},
+		{
+			name:    "NoLabels",
+			postIDs: []string{"label-1"},
+			labels:  nil,
+			wantErr: "at least one label is required",
+		},
+		{
+			name:    "InvalidPostID",
+			postIDs: []string{"label-1", "../admin"},
+			labels:  []string{"campaign"},
+			wantErr: "invalid post ID",
+		},
+		{
+			name:    "UnknownPostID",
+			postIDs: []string{"label-1", "missing-1", "missing-2"},
+			labels:  []string{"campaign"},
+			wantErr: "Unknown post IDs: missing-1, missing-2",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.AddPosts([]v1.Post{{ID: "label-1", Text: "First", State: "scheduled"}})
+
+			err := client.AddLabels(context.Background(), test.postIDs, test.labels)
+			require.Error(t, err)
+			require.ErrorContains(t, err, test.wantErr)
+
+			var resp v1.GetPostResponse
+			err = client.GetPost(context.Background(), v1.GetPostRequest{PostID: "label-1"}, &resp)
+			require.NoError(t, err)
+			assert.Empty(t, resp.Labels)
+		})
+	}
+}
+
 func TestPostNotFound(t *testing.T) {
 	server := v1.SpawnMockServer()
 	defer func() { _ = server.Stop() }()
@@ -234,6 +743,89 @@ func TestUpdatePostPartialUpdates(t *testing.T) {
 	assert.True(t, resp3.HasMedia) // Should be updated to true
 }
 
+func TestUpdatePostAccounts(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddAccount(v1.Account{ID: "account-2", Provider: "linkedin"})
+	server.AddPosts([]v1.Post{{
+		ID:        "post-accounts",
+		Text:      "Original text",
+		State:     "scheduled",
+		AccountID: "account-1",
+		Network:   "twitter",
+	}})
+
+	// Accounts left nil are untouched by a partial update
+	var resp v1.UpdatePostResponse
+	err := client.UpdatePost(context.Background(), v1.UpdatePostRequest{
+		PostID: "post-accounts",
+		Text:   "New text",
+	}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "account-1", resp.AccountID)
+	assert.Equal(t, "twitter", resp.Network)
+
+	err = client.UpdatePost(context.Background(), v1.UpdatePostRequest{
+		PostID:   "post-accounts",
+		Accounts: []string{"account-2"},
+	}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "account-2", resp.AccountID)
+	assert.Equal(t, "linkedin", resp.Network)
+	assert.Equal(t, "New text", resp.Text)
+}
+
+func TestUpdatePostAccountsValidation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name        string
+		accounts    []string
+		expectedErr string
+	}{
+		{
+			name:        "Empty",
+			accounts:    []string{},
+			expectedErr: "accounts cannot be empty when provided",
+		},
+		{
+			name:        "InvalidFormat",
+			accounts:    []string{"account/1"},
+			expectedErr: `invalid account ID "account/1"`,
+		},
+		{
+			name:        "MultipleAccounts",
+			accounts:    []string{"account-1", "account-2"},
+			expectedErr: "A post must target exactly one account",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.AddPosts([]v1.Post{{ID: "post-accounts", Text: "Original text", AccountID: "account-1"}})
+
+			var resp v1.UpdatePostResponse
+			err := client.UpdatePost(context.Background(), v1.UpdatePostRequest{
+				PostID:   "post-accounts",
+				Text:     "Should not apply",
+				Accounts: test.accounts,
+			}, &resp)
+			require.ErrorContains(t, err, test.expectedErr)
+
+			var getResp v1.GetPostResponse
+			require.NoError(t, client.GetPost(context.Background(), v1.GetPostRequest{PostID: "post-accounts"}, &getResp))
+			assert.Equal(t, "Original text", getResp.Text)
+			assert.Equal(t, "account-1", getResp.AccountID)
+		})
+	}
+}
+
 func TestPostManagementWithDifferentStates(t *testing.T) {
 	server := v1.SpawnMockServer()
 	defer func() { _ = server.Stop() }()
@@ -318,4 +910,277 @@ func TestPostIDValidation(t *testing.T) {
 			require.ErrorContains(t, err, "invalid post ID")
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestGetPostComments(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	var comments []v1.Comment
+	for i := 1; i <= 25; i++ {
+		comments = append(comments, v1.Comment{
+			ID:        fmt.Sprintf("comment-%02d", i),
+			Author:    "fan",
+			Text:      fmt.Sprintf("Reply %d", i),
+			CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		})
+	}
+
+	server.Reset()
+	server.AddPosts([]v1.Post{{ID: "post-01", State: "published"}})
+	server.SetPostComments("post-01", comments)
+
+	ctx := context.Background()
+	it := client.GetPostComments(ctx, v1.GetPostCommentsRequest{PostID: "post-01"})
+
+	var pageSizes []int
+	var got []v1.Comment
+	for {
+		var page v1.Page[v1.Comment]
+		more := it.Next(ctx, &page)
+		require.NoError(t, it.Err())
+		pageSizes = append(pageSizes, len(page.Items))
+		got = append(got, page.Items...)
+		if !more {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{10, 10, 5}, pageSizes)
+	assert.Equal(t, comments, got)
+}
+
+func TestGetPostCommentsEmpty(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{{ID: "post-01", State: "published"}})
+
+	ctx := context.Background()
+	it := client.GetPostComments(ctx, v1.GetPostCommentsRequest{PostID: "post-01"})
+
+	var page v1.Page[v1.Comment]
+	assert.False(t, it.Next(ctx, &page))
+	require.NoError(t, it.Err())
+	assert.Empty(t, page.Items)
+}
+
+func TestGetPostCommentsErrors(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	for _, test := range []struct {
+		name        string
+		postID      string
+		expectedErr string
+	}{
+		{
+			name:        "EmptyPostID",
+			postID:      "",
+			expectedErr: "invalid post ID: post ID cannot be empty",
+		},
+		{
+			name:        "PathTraversal",
+			postID:      "../accounts",
+			expectedErr: "invalid post ID: post ID contains invalid characters",
+		},
+		{
+			name:        "UnknownPost",
+			postID:      "post-missing",
+			expectedErr: "Post not found",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			it := client.GetPostComments(ctx, v1.GetPostCommentsRequest{PostID: test.postID})
+
+			var page v1.Page[v1.Comment]
+			assert.False(t, it.Next(ctx, &page))
+			require.Error(t, it.Err())
+			assert.Contains(t, it.Err().Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestReplyToComment(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetCurrentUser(v1.User{ID: "user-1", Name: "Brand Team"})
+	server.AddPosts([]v1.Post{{ID: "post-01", State: "published"}})
+	server.SetPostComments("post-01", []v1.Comment{
+		{ID: "comment-01", Author: "fan", Text: "Love this!"},
+	})
+
+	var resp v1.ReplyToCommentResponse
+	err := client.ReplyToComment(context.Background(), v1.ReplyToCommentRequest{
+		PostID:    "post-01",
+		CommentID: "comment-01",
+		Text:      "Thank you!",
+	}, &resp)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.ID)
+	assert.Equal(t, "Thank you!", resp.Text)
+	assert.Equal(t, "Brand Team", resp.Author)
+	assert.Equal(t, "comment-01", resp.ParentID)
+
+	// The reply is listed alongside the original comment
+	ctx := context.Background()
+	it := client.GetPostComments(ctx, v1.GetPostCommentsRequest{PostID: "post-01"})
+	var page v1.Page[v1.Comment]
+	it.Next(ctx, &page)
+	require.NoError(t, it.Err())
+	require.Len(t, page.Items, 2)
+	assert.Equal(t, resp.Comment, page.Items[1])
+}
+
+func TestReplyToCommentErrors(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name        string
+		req         v1.ReplyToCommentRequest
+		expectedErr string
+	}{
+		{
+			name:        "MissingComment",
+			req:         v1.ReplyToCommentRequest{PostID: "post-01", CommentID: "comment-99", Text: "Hi"},
+			expectedErr: "Comment not found",
+		},
+		{
+			name:        "InvalidPostID",
+			req:         v1.ReplyToCommentRequest{PostID: "", CommentID: "comment-01", Text: "Hi"},
+			expectedErr: "invalid post ID: post ID cannot be empty",
+		},
+		{
+			name:        "InvalidCommentID",
+			req:         v1.ReplyToCommentRequest{PostID: "post-01", CommentID: "../x", Text: "Hi"},
+			expectedErr: "invalid comment ID: comment ID must contain only alphanumeric characters, hyphens, and underscores",
+		},
+		{
+			name:        "EmptyText",
+			req:         v1.ReplyToCommentRequest{PostID: "post-01", CommentID: "comment-01"},
+			expectedErr: "reply text cannot be empty",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.SetPostComments("post-01", []v1.Comment{{ID: "comment-01", Text: "Love this!"}})
+
+			var resp v1.ReplyToCommentResponse
+			err := client.ReplyToComment(context.Background(), test.req, &resp)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestGetPostThumbnail(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{
+			ID:        "thumb-1",
+			Text:      "Generated thumbnail",
+			HasMedia:  true,
+			Media:     []v1.Media{{URL: "https://example.com/video.mp4", Type: "video"}},
+			Thumbnail: "https://cdn.example.com/video-preview.jpg",
+		},
+		{
+			ID:       "thumb-2",
+			Text:     "First media item",
+			HasMedia: true,
+			Media: []v1.Media{
+				{URL: "https://example.com/first.jpg", Type: "image"},
+				{URL: "https://example.com/second.jpg", Type: "image"},
+			},
+		},
+		{ID: "thumb-3", Text: "No media"},
+	})
+
+	for _, test := range []struct {
+		name      string
+		postID    string
+		thumbnail string
+	}{
+		{name: "FromAPI", postID: "thumb-1", thumbnail: "https://cdn.example.com/video-preview.jpg"},
+		{name: "FromFirstMedia", postID: "thumb-2", thumbnail: "https://example.com/first.jpg"},
+		{name: "NoMedia", postID: "thumb-3", thumbnail: ""},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var resp v1.GetPostResponse
+			err := client.GetPost(context.Background(), v1.GetPostRequest{PostID: test.postID}, &resp)
+			require.NoError(t, err)
+			assert.Equal(t, test.thumbnail, resp.Thumbnail)
+		})
+	}
+}
+
+func TestListPostsThumbnail(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{
+			ID:       "thumb-1",
+			Text:     "First media item",
+			HasMedia: true,
+			Media: []v1.Media{
+				{URL: "https://example.com/first.jpg", Type: "image"},
+				{URL: "https://example.com/second.jpg", Type: "image"},
+			},
+		},
+	})
+
+	page, err := client.ListPostsPage(context.Background(), v1.ListPostsRequest{}, 1)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, "https://example.com/first.jpg", page.Items[0].Thumbnail)
+}
+
+func TestPublishedPostThumbnail(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+	server.AddAccount(v1.Account{ID: "acc-1", Provider: "instagram"})
+
+	var resp v1.PublishResponse
+	err := client.Publish(context.Background(), v1.PublishRequest{
+		Text:     "Photo post",
+		Accounts: []string{"acc-1"},
+		Media:    []v1.Media{{URL: "https://example.com/photo.jpg", Type: "image"}},
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "https://example.com/photo.jpg", posts[0].Thumbnail)
+}
@@ -1,19 +1,86 @@
 package v1
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // ScheduleRequest represents scheduled post creation
 type ScheduleRequest struct {
-	ScheduledAt time.Time `json:"scheduled_at"`
-	TimeZone    string    `json:"timezone,omitempty"`
-	Accounts    []string  `json:"accounts"`
-	Media       []Media   `json:"media,omitempty"`
-	Text        string    `json:"text"`
+	ScheduledAt time.Time         `json:"scheduled_at"`
+	TimeZone    string            `json:"timezone,omitempty"`
+	Accounts    []string          `json:"accounts"`
+	Media       []Media           `json:"media,omitempty"`
+	Text        string            `json:"text"`
+	PostKind    string            `json:"post_kind,omitempty"` // feed, story, reel or short; checked per network by the server
+	Variants    map[string]string `json:"variants,omitempty"`  // network -> text, Text is the fallback
+	Link        string            `json:"link,omitempty"`      // shared as a link preview card, separate from Text
+	Thread      []string          `json:"thread,omitempty"`    // follow-up parts posted as replies after Text; network support is checked by the server
+	Poll        *Poll             `json:"poll,omitempty"`
+
+	// ExpiresAt, when set, deletes the post from the network at that time. It must
+	// be after ScheduledAt.
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+
+	// RespectQuietHours moves the scheduled time past the quiet hours of every target
+	// account. The chosen time is reported in ScheduleResponse.ResolvedTime.
+	RespectQuietHours bool `json:"respect_quiet_hours,omitempty"`
+
+	// UTM, when set, is appended to every URL in Text, Link, Variants and Thread
+	// before sending
+	UTM *UTMParams `json:"-"`
+
+	// Auto asks Publer to pick the account's next optimal slot, sending "auto" as
+	// scheduled_at. It cannot be combined with ScheduledAt.
+	Auto bool `json:"-"`
+
+	// SkipInactiveAccounts drops accounts whose connection is not active, such as
+	// expired accounts, before sending. The skipped accounts are reported in
+	// ScheduleResponse.SkippedAccounts.
+	SkipInactiveAccounts bool `json:"-"`
 }
 
-// ScheduleResponse contains job ID for async processing
+// scheduleRequestFields has the fields of ScheduleRequest without its JSON methods
+type scheduleRequestFields ScheduleRequest
+
+// MarshalJSON sends scheduled_at as "auto" when Auto is set
+func (r ScheduleRequest) MarshalJSON() ([]byte, error) {
+	if !r.Auto {
+		return json.Marshal(scheduleRequestFields(r))
+	}
+	return json.Marshal(struct {
+		scheduleRequestFields
+		ScheduledAt string `json:"scheduled_at"`
+	}{scheduleRequestFields(r), "auto"})
+}
+
+// UnmarshalJSON accepts either a timestamp or "auto" for scheduled_at
+func (r *ScheduleRequest) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*scheduleRequestFields
+		ScheduledAt json.RawMessage `json:"scheduled_at"`
+	}{scheduleRequestFields: (*scheduleRequestFields)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	r.Auto = string(aux.ScheduledAt) == `"auto"`
+	r.ScheduledAt = time.Time{}
+	if r.Auto || len(aux.ScheduledAt) == 0 || string(aux.ScheduledAt) == "null" {
+		return nil
+	}
+	return json.Unmarshal(aux.ScheduledAt, &r.ScheduledAt)
+}
+
+// ScheduleResponse contains job ID for async processing along with the
+// time and time zone the server resolved the scheduled time to
 type ScheduleResponse struct {
-	JobID string `json:"job_id"`
+	JobID        string    `json:"job_id"`
+	ResolvedTime time.Time `json:"resolved_time,omitzero"`
+	TimeZone     string    `json:"timezone,omitempty"`
+
+	// SkippedAccounts lists the inactive accounts dropped by SkipInactiveAccounts
+	SkippedAccounts []string `json:"-"`
 }
 
 // CreateDraftRequest represents draft post creation
@@ -1,7 +1,9 @@
 package v1_test
 
 import (
+	"bytes"
 	"context"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -155,56 +157,256 @@ func TestWaitForJobTimeout(t *testing.T) {
 	require.ErrorIs(t, err, context.DeadlineExceeded)
 }
 
-func TestPublishPost(t *testing.T) {
+func TestWaitForJobTransientErrors(t *testing.T) {
 	server := v1.SpawnMockServer()
 	defer func() { _ = server.Stop() }()
 
 	client := server.Client()
 
-	req := v1.PublishRequest{
-		Accounts: []string{"account-1", "account-2"},
-		Text:     "Test post content",
+	const jobID = "test-job-transient"
+	for _, test := range []struct {
+		name       string
+		failures   int
+		statusCode int
+		maxErrors  int
+		wantStatus int
+	}{
+		{
+			name:       "RecoversAfterTransient503",
+			failures:   1,
+			statusCode: 503,
+		},
+		{
+			name:       "RecoversWithinLimit",
+			failures:   2,
+			statusCode: 502,
+			maxErrors:  2,
+		},
+		{
+			name:       "ExceedsLimit",
+			failures:   3,
+			statusCode: 503,
+			maxErrors:  2,
+			wantStatus: 503,
+		},
+		{
+			name:       "NonRetryableAborts",
+			failures:   1,
+			statusCode: 403,
+			wantStatus: 403,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.SetJobStatus(jobID, "completed", 100, &v1.JobResult{Success: true}, "")
+			server.SetTransientError("GET", "/api/v1/job_status/"+jobID, test.failures, test.statusCode)
+
+			var result v1.JobResult
+			err := client.WaitForJob(context.Background(), v1.WaitOptions{
+				JobID:                jobID,
+				InitialDelay:         5 * time.Millisecond,
+				MaxDelay:             10 * time.Millisecond,
+				Jitter:               time.Millisecond,
+				MaxConsecutiveErrors: test.maxErrors,
+			}, &result)
+
+			if test.wantStatus != 0 {
+				var apiErr *v1.APIError
+				require.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, test.wantStatus, apiErr.StatusCode)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, result.Success)
+			assert.Equal(t, test.failures+1, server.CallCount("GET", "/api/v1/job_status/"+jobID))
+		})
 	}
+}
 
-	var resp v1.PublishResponse
+func TestWaitForJobSchedule(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	const jobID = "test-job-schedule"
 	server.Reset()
+	server.SetJobProgression(jobID, []v1.JobStatus{
+		{ID: jobID, Status: "pending"},
+		{ID: jobID, Status: "working", Progress: 50},
+		{ID: jobID, Status: "completed", Progress: 100, Result: &v1.JobResult{Success: true}},
+	})
 
-	err := client.Publish(context.Background(), req, &resp)
+	type scheduleCall struct {
+		attempt int
+		last    time.Duration
+	}
+	var calls []scheduleCall
+	opts := v1.WaitOptions{
+		JobID:        jobID,
+		InitialDelay: time.Hour,
+		Schedule: func(attempt int, last time.Duration) time.Duration {
+			calls = append(calls, scheduleCall{attempt: attempt, last: last})
+			// Each poll after the first sees the job one step further along
+			if attempt > 1 {
+				server.AdvanceJobState(jobID)
+			}
+			return 5 * time.Millisecond
+		},
+	}
+
+	var result v1.JobResult
+	err := client.WaitForJob(context.Background(), opts, &result)
 	require.NoError(t, err)
-	assert.NotEmpty(t, resp.JobID)
+	assert.True(t, result.Success)
+	assert.Equal(t, []scheduleCall{
+		{attempt: 1, last: 0},
+		{attempt: 2, last: 5 * time.Millisecond},
+		{attempt: 3, last: 5 * time.Millisecond},
+	}, calls)
+}
 
-	// Verify job status endpoint returns status for the created job
-	jobReq := v1.GetJobStatusRequest(resp)
-	var jobResp v1.GetJobStatusResponse
-	err = client.GetJobStatus(context.Background(), jobReq, &jobResp)
+func TestGetJobStatuses(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetJobStatus("job-a", "completed", 100, &v1.JobResult{Success: true}, "")
+	server.SetJobStatus("job-b", "working", 50, nil, "")
+	server.SetJobStatus("job-c", "failed", 0, nil, "Processing failed")
+
+	statuses, err := client.GetJobStatuses(context.Background(), []string{"job-a", "job-b", "job-c", "job-unknown"})
 	require.NoError(t, err)
-	assert.Equal(t, resp.JobID, jobResp.ID)
-	assert.Equal(t, "pending", jobResp.Status)
-	assert.Equal(t, 0, jobResp.Progress)
+	require.Len(t, statuses, 3)
+	assert.Equal(t, "completed", statuses["job-a"].Status)
+	assert.Equal(t, "working", statuses["job-b"].Status)
+	assert.Equal(t, 50, statuses["job-b"].Progress)
+	assert.Equal(t, "Processing failed", statuses["job-c"].Error)
+	assert.Equal(t, 1, server.CallCount("GET", "/api/v1/job_status"))
+
+	_, err = client.GetJobStatuses(context.Background(), nil)
+	require.ErrorContains(t, err, "at least one job ID is required")
 }
 
-func TestSchedulePost(t *testing.T) {
+func TestWaitForJobs(t *testing.T) {
 	server := v1.SpawnMockServer()
 	defer func() { _ = server.Stop() }()
 
 	client := server.Client()
 
-	req := v1.ScheduleRequest{
-		ScheduledAt: time.Now().Add(time.Hour),
-		TimeZone:    "UTC",
-		Accounts:    []string{"account-1", "account-2"},
-		Text:        "Scheduled post content",
+	server.Reset()
+	server.SetJobStatus("job-a", "completed", 100, &v1.JobResult{Success: true, PostIDs: []string{"post-a"}}, "")
+	server.SetJobStatus("job-b", "working", 50, nil, "")
+	server.SetJobStatus("job-c", "completed", 100, &v1.JobResult{Success: true, PostIDs: []string{"post-c"}}, "")
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		server.SetJobStatus("job-b", "completed", 100, &v1.JobResult{Success: true, PostIDs: []string{"post-b"}}, "")
+	}()
+
+	results, err := client.WaitForJobs(context.Background(), []string{"job-a", "job-b", "job-c"}, v1.WaitOptions{
+		InitialDelay: 10 * time.Millisecond,
+		MaxDelay:     20 * time.Millisecond,
+		Jitter:       5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, []string{"post-a"}, results["job-a"].PostIDs)
+	assert.Equal(t, []string{"post-b"}, results["job-b"].PostIDs)
+	assert.Equal(t, []string{"post-c"}, results["job-c"].PostIDs)
+
+	// Every poll covers all pending jobs in a single request
+	assert.Greater(t, server.CallCount("GET", "/api/v1/job_status"), 1)
+	assert.Equal(t, 0, server.CallCount("GET", "/api/v1/job_status/job-b"))
+}
+
+func TestWaitForJobsSubMillisecondJitter(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetJobStatus("job-a", "working", 50, nil, "")
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		server.SetJobStatus("job-a", "completed", 100, &v1.JobResult{Success: true}, "")
+	}()
+
+	// Polls after the first add jitter, which must not round down to nothing and panic
+	results, err := client.WaitForJobs(context.Background(), []string{"job-a"}, v1.WaitOptions{
+		InitialDelay: 5 * time.Millisecond,
+		MaxDelay:     10 * time.Millisecond,
+		Jitter:       time.Microsecond,
+	})
+	require.NoError(t, err)
+	assert.True(t, results["job-a"].Success)
+	assert.Greater(t, server.CallCount("GET", "/api/v1/job_status"), 1)
+}
+
+func TestWaitForJobsFailed(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetJobStatus("job-a", "completed", 100, &v1.JobResult{Success: true}, "")
+	server.SetJobStatus("job-b", "failed", 0, nil, "Processing failed")
+
+	results, err := client.WaitForJobs(context.Background(), []string{"job-a", "job-b"}, v1.WaitOptions{
+		InitialDelay: 10 * time.Millisecond,
+	})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "job job-b failed: Processing failed")
+	assert.True(t, results["job-a"].Success)
+	assert.False(t, results["job-b"].Success)
+	assert.Equal(t, 1, server.CallCount("GET", "/api/v1/job_status"))
+}
+
+func TestWaitForJobsFallback(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetErrorResponse("GET", "/api/v1/job_status", 0, 404, v1.ErrorResponse{Error: "not_found"}, nil)
+	server.SetJobStatus("job-a", "completed", 100, &v1.JobResult{Success: true}, "")
+	server.SetJobStatus("job-b", "completed", 100, &v1.JobResult{Success: true}, "")
+
+	results, err := client.WaitForJobs(context.Background(), []string{"job-a", "job-b"}, v1.WaitOptions{
+		InitialDelay: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, 1, server.CallCount("GET", "/api/v1/job_status/job-a"))
+	assert.Equal(t, 1, server.CallCount("GET", "/api/v1/job_status/job-b"))
+}
+
+func TestPublishPost(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	req := v1.PublishRequest{
+		Accounts: []string{"account-1", "account-2"},
+		Text:     "Test post content",
 	}
 
-	var resp v1.ScheduleResponse
+	var resp v1.PublishResponse
 	server.Reset()
 
-	err := client.Schedule(context.Background(), req, &resp)
+	err := client.Publish(context.Background(), req, &resp)
 	require.NoError(t, err)
 	assert.NotEmpty(t, resp.JobID)
 
 	// Verify job status endpoint returns status for the created job
-	jobReq := v1.GetJobStatusRequest(resp)
+	jobReq := v1.GetJobStatusRequest{JobID: resp.JobID}
 	var jobResp v1.GetJobStatusResponse
 	err = client.GetJobStatus(context.Background(), jobReq, &jobResp)
 	require.NoError(t, err)
@@ -213,113 +415,1513 @@ func TestSchedulePost(t *testing.T) {
 	assert.Equal(t, 0, jobResp.Progress)
 }
 
-func TestSchedulePostValidation(t *testing.T) {
+func TestPublishPostStrictValidation(t *testing.T) {
 	server := v1.SpawnMockServer()
 	defer func() { _ = server.Stop() }()
 
 	client := server.Client()
 
 	for _, test := range []struct {
-		name    string
-		request v1.ScheduleRequest
-		wantErr string
+		name      string
+		request   v1.PublishRequest
+		wantErr   string
+		clientErr bool
 	}{
 		{
-			name: "PastScheduledTime",
-			request: v1.ScheduleRequest{
-				ScheduledAt: time.Now().Add(-time.Hour),
-				Accounts:    []string{"account-1"},
-				Text:        "Test post",
+			name: "MissingTextAndMedia",
+			request: v1.PublishRequest{
+				Accounts: []string{"account-1"},
 			},
-			wantErr: "Scheduled time must be in the future",
+			wantErr: "Text or media is required",
+		},
+		{
+			name: "MissingAccounts",
+			request: v1.PublishRequest{
+				Text: "Test post",
+			},
+			wantErr:   "at least one account is required",
+			clientErr: true,
+		},
+		{
+			name: "MediaOnly",
+			request: v1.PublishRequest{
+				Accounts: []string{"account-1"},
+				Media:    []v1.Media{{URL: "https://example.com/image.jpg", Type: "image"}},
+			},
+			wantErr: "",
+		},
+		{
+			name: "TextOnly",
+			request: v1.PublishRequest{
+				Accounts: []string{"account-1"},
+				Text:     "Test post",
+			},
+			wantErr: "",
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			server.Reset()
+			server.SetStrictValidation(true)
+
+			var resp v1.PublishResponse
+			err := client.Publish(context.Background(), test.request, &resp)
+
+			if test.wantErr == "" {
+				require.NoError(t, err)
+				assert.NotEmpty(t, resp.JobID)
+				return
+			}
 
-			var resp v1.ScheduleResponse
-			err := client.Schedule(context.Background(), test.request, &resp)
 			require.Error(t, err)
 			require.ErrorContains(t, err, test.wantErr)
+			if test.clientErr {
+				assert.Equal(t, 0, server.CallCount("POST", "/api/v1/posts/schedule/publish"))
+				return
+			}
+
+			var apiErr *v1.APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, 400, apiErr.StatusCode)
 		})
 	}
 }
 
-func TestCreateDraftPost(t *testing.T) {
+func TestPublishPostLaxValidation(t *testing.T) {
 	server := v1.SpawnMockServer()
 	defer func() { _ = server.Stop() }()
 
 	client := server.Client()
 
-	req := v1.CreateDraftRequest{
-		Accounts:   []string{"account-1", "account-2"},
-		Visibility: "draft_private",
-		Text:       "Draft post content",
+	server.Reset()
+
+	var resp v1.PublishResponse
+	err := client.Publish(context.Background(), v1.PublishRequest{
+		Accounts: []string{"account-1"},
+	}, &resp)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.JobID)
+}
+
+func TestDefaultAccounts(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	for _, test := range []struct {
+		name            string
+		defaultAccounts []string
+		contextAccounts []string
+		accounts        []string
+		wantAccounts    []string
+		wantErr         string
+	}{
+		{
+			name:            "DefaultApplied",
+			defaultAccounts: []string{"default-1", "default-2"},
+			accounts:        nil,
+			wantAccounts:    []string{"default-1", "default-2"},
+		},
+		{
+			name:            "ExplicitOverridesDefault",
+			defaultAccounts: []string{"default-1", "default-2"},
+			accounts:        []string{"explicit-1"},
+			wantAccounts:    []string{"explicit-1"},
+		},
+		{
+			name:         "ExplicitWithoutDefault",
+			accounts:     []string{"explicit-1"},
+			wantAccounts: []string{"explicit-1"},
+		},
+		{
+			name:    "BothEmpty",
+			wantErr: "at least one account is required",
+		},
+		{
+			name:            "ContextOverridesConfig",
+			defaultAccounts: []string{"default-1"},
+			contextAccounts: []string{"session-1", "session-2"},
+			wantAccounts:    []string{"session-1", "session-2"},
+		},
+		{
+			name:            "ContextWithoutConfig",
+			contextAccounts: []string{"session-1"},
+			wantAccounts:    []string{"session-1"},
+		},
+		{
+			name:            "ExplicitOverridesContext",
+			defaultAccounts: []string{"default-1"},
+			contextAccounts: []string{"session-1"},
+			accounts:        []string{"explicit-1"},
+			wantAccounts:    []string{"explicit-1"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.SetPersistCreatedPosts(true)
+
+			client := server.ClientWithConfig(v1.Config{
+				DefaultAccounts: test.defaultAccounts,
+			})
+
+			ctx := context.Background()
+			if test.contextAccounts != nil {
+				ctx = v1.WithDefaultAccounts(ctx, test.contextAccounts...)
+			}
+
+			var resp v1.PublishResponse
+			err := client.Publish(ctx, v1.PublishRequest{
+				Text:     "Default accounts post",
+				Accounts: test.accounts,
+			}, &resp)
+
+			if test.wantErr != "" {
+				require.Error(t, err)
+				require.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+			require.NoError(t, err)
+
+			var accounts []string
+			for _, post := range posts {
+				accounts = append(accounts, post.AccountID)
+			}
+			assert.Equal(t, test.wantAccounts, accounts)
+		})
 	}
+}
+
+func TestDefaultAccountsSchedule(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.ClientWithConfig(v1.Config{
+		DefaultAccounts: []string{"default-1"},
+	})
 
-	var resp v1.CreateDraftResponse
 	server.Reset()
+	server.SetPersistCreatedPosts(true)
 
-	err := client.CreateDraft(context.Background(), req, &resp)
+	var resp v1.ScheduleResponse
+	err := client.Schedule(context.Background(), v1.ScheduleRequest{
+		ScheduledAt: time.Now().Add(time.Hour),
+		Text:        "Scheduled with defaults",
+	}, &resp)
 	require.NoError(t, err)
-	assert.NotEmpty(t, resp.JobID)
 
-	// Verify job status endpoint returns status for the created job
-	jobReq := v1.GetJobStatusRequest(resp)
-	var jobResp v1.GetJobStatusResponse
-	err = client.GetJobStatus(context.Background(), jobReq, &jobResp)
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
 	require.NoError(t, err)
-	assert.Equal(t, resp.JobID, jobResp.ID)
-	assert.Equal(t, "pending", jobResp.Status)
-	assert.Equal(t, 0, jobResp.Progress)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "default-1", posts[0].AccountID)
 }
 
-func TestDraftVisibility(t *testing.T) {
+func TestDefaultAccountsContextSchedule(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.ClientWithConfig(v1.Config{
+		DefaultAccounts: []string{"default-1"},
+	})
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+
+	ctx := v1.WithDefaultAccounts(context.Background(), "session-1")
+	var resp v1.ScheduleResponse
+	err := client.Schedule(ctx, v1.ScheduleRequest{
+		ScheduledAt: time.Now().Add(time.Hour),
+		Text:        "Scheduled with session defaults",
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "session-1", posts[0].AccountID)
+}
+
+func TestPublishPostKind(t *testing.T) {
 	server := v1.SpawnMockServer()
 	defer func() { _ = server.Stop() }()
 
 	client := server.Client()
 
 	for _, test := range []struct {
-		name       string
-		visibility string
-		wantErr    string
+		name     string
+		account  v1.Account
+		postKind string
+		wantErr  string
 	}{
 		{
-			name:       "ValidPrivate",
-			visibility: "draft_private",
-			wantErr:    "",
+			name:     "InstagramStory",
+			account:  v1.Account{ID: "ig-1", Provider: "instagram"},
+			postKind: v1.PostKindStory,
 		},
 		{
-			name:       "ValidPublic",
-			visibility: "draft_public",
-			wantErr:    "",
+			name:     "InstagramReel",
+			account:  v1.Account{ID: "ig-1", Provider: "instagram"},
+			postKind: v1.PostKindReel,
 		},
 		{
-			name:       "InvalidVisibility",
-			visibility: "invalid_visibility",
-			wantErr:    "Invalid visibility. Must be draft_private or draft_public",
+			name:     "YouTubeShort",
+			account:  v1.Account{ID: "yt-1", Provider: "youtube"},
+			postKind: v1.PostKindShort,
+		},
+		{
+			name:     "TwitterFeed",
+			account:  v1.Account{ID: "tw-1", Provider: "twitter"},
+			postKind: v1.PostKindFeed,
+		},
+		{
+			name:     "UnsupportedForNetwork",
+			account:  v1.Account{ID: "tw-1", Provider: "twitter"},
+			postKind: v1.PostKindStory,
+			wantErr:  "Post kind story is not supported for twitter",
+		},
+		{
+			name:     "UnknownKind",
+			account:  v1.Account{ID: "ig-1", Provider: "instagram"},
+			postKind: "carousel",
+			wantErr:  "invalid post kind",
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			server.Reset()
+			server.SetPersistCreatedPosts(true)
+			server.AddAccount(test.account)
 
-			req := v1.CreateDraftRequest{
-				Visibility: test.visibility,
-				Accounts:   []string{"account-1"},
-				Text:       "Test draft",
-			}
-
-			var resp v1.CreateDraftResponse
-			err := client.CreateDraft(context.Background(), req, &resp)
+			var resp v1.PublishResponse
+			err := client.Publish(context.Background(), v1.PublishRequest{
+				Text:     "Post kind content",
+				Accounts: []string{test.account.ID},
+				PostKind: test.postKind,
+			}, &resp)
 
-			if test.wantErr == "" {
-				require.NoError(t, err)
-				assert.NotEmpty(t, resp.JobID)
-			} else {
+			if test.wantErr != "" {
 				require.Error(t, err)
 				require.ErrorContains(t, err, test.wantErr)
+				return
 			}
+			require.NoError(t, err)
+
+			posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+			require.NoError(t, err)
+			require.Len(t, posts, 1)
+			assert.Equal(t, test.postKind, posts[0].PostKind)
+			assert.Equal(t, test.account.Provider, posts[0].Network)
 		})
 	}
 }
+
+func TestSchedulePostKind(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+	server.AddAccount(v1.Account{ID: "fb-1", Provider: "facebook"})
+
+	var resp v1.ScheduleResponse
+	err := client.Schedule(context.Background(), v1.ScheduleRequest{
+		ScheduledAt: time.Now().Add(time.Hour),
+		Accounts:    []string{"fb-1"},
+		Text:        "Scheduled reel",
+		PostKind:    v1.PostKindReel,
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, v1.PostKindReel, posts[0].PostKind)
+	assert.Equal(t, "scheduled", posts[0].State)
+
+	err = client.Schedule(context.Background(), v1.ScheduleRequest{
+		ScheduledAt: time.Now().Add(time.Hour),
+		Accounts:    []string{"fb-1"},
+		Text:        "Scheduled short",
+		PostKind:    v1.PostKindShort,
+	}, &resp)
+	require.Error(t, err)
+	require.ErrorContains(t, err, "Post kind short is not supported for facebook")
+}
+
+func TestPublishPostVariants(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+	server.AddAccount(v1.Account{ID: "fb-1", Provider: "facebook"})
+	server.AddAccount(v1.Account{ID: "tw-1", Provider: "twitter"})
+	server.AddAccount(v1.Account{ID: "li-1", Provider: "linkedin"})
+
+	var resp v1.PublishResponse
+	err := client.Publish(context.Background(), v1.PublishRequest{
+		Text:     "A longer announcement for everyone",
+		Accounts: []string{"fb-1", "tw-1", "li-1"},
+		Variants: map[string]string{
+			"twitter":  "Short announcement",
+			"linkedin": "A professional announcement",
+		},
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+
+	texts := make(map[string]string)
+	for _, post := range posts {
+		texts[post.Network] = post.Text
+	}
+	assert.Equal(t, map[string]string{
+		"facebook": "A longer announcement for everyone",
+		"twitter":  "Short announcement",
+		"linkedin": "A professional announcement",
+	}, texts)
+}
+
+func TestSchedulePostVariants(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+	server.AddAccount(v1.Account{ID: "fb-1", Provider: "facebook"})
+	server.AddAccount(v1.Account{ID: "tw-1", Provider: "twitter"})
+
+	var resp v1.ScheduleResponse
+	err := client.Schedule(context.Background(), v1.ScheduleRequest{
+		ScheduledAt: time.Now().Add(time.Hour),
+		Text:        "Scheduled announcement for everyone",
+		Accounts:    []string{"fb-1", "tw-1"},
+		Variants:    map[string]string{"twitter": "Scheduled tweet"},
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+
+	texts := make(map[string]string)
+	for _, post := range posts {
+		texts[post.Network] = post.Text
+	}
+	assert.Equal(t, map[string]string{
+		"facebook": "Scheduled announcement for everyone",
+		"twitter":  "Scheduled tweet",
+	}, texts)
+}
+
+func TestPublishPostLink(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name string
+		link string
+	}{
+		{
+			name: "WithLink",
+			link: "https://example.com/launch",
+		},
+		{
+			name: "WithoutLink",
+			link: "",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.SetPersistCreatedPosts(true)
+
+			var resp v1.PublishResponse
+			err := client.Publish(context.Background(), v1.PublishRequest{
+				Text:     "Read about our launch",
+				Link:     test.link,
+				Accounts: []string{"account-1"},
+			}, &resp)
+			require.NoError(t, err)
+
+			posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+			require.NoError(t, err)
+			require.Len(t, posts, 1)
+			assert.Equal(t, "Read about our launch", posts[0].Text)
+			assert.Equal(t, test.link, posts[0].URL)
+			assert.Equal(t, test.link, posts[0].PostLink)
+		})
+	}
+}
+
+func TestPublishMediaAltText(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+
+	media := []v1.Media{
+		{URL: "https://example.com/chart.png", Type: "image", AltText: "Bar chart of weekly signups"},
+		{URL: "https://example.com/demo.mp4", Type: "video"},
+	}
+	var resp v1.PublishResponse
+	err := client.Publish(context.Background(), v1.PublishRequest{
+		Text:     "Signups this week",
+		Accounts: []string{"account-1"},
+		Media:    media,
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, media, posts[0].Media)
+}
+
+func TestWarnMissingAltText(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	media := []v1.Media{
+		{URL: "https://example.com/chart.png", Type: "image", AltText: "Bar chart of weekly signups"},
+		{URL: "https://example.com/photo.jpg", Type: "image"},
+		{URL: "https://example.com/demo.mp4", Type: "video"},
+	}
+
+	for _, test := range []struct {
+		name     string
+		warn     bool
+		expected string
+	}{
+		{
+			name:     "Enabled",
+			warn:     true,
+			expected: "level=WARN msg=\"image has no alt text\" media=2 url=https://example.com/photo.jpg\n",
+		},
+		{
+			name:     "Disabled",
+			warn:     false,
+			expected: "",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+
+			var logs bytes.Buffer
+			handler := slog.NewTextHandler(&logs, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+					if attr.Key == slog.TimeKey {
+						return slog.Attr{}
+					}
+					return attr
+				},
+			})
+			client := server.ClientWithConfig(v1.Config{
+				WarnMissingAltText: test.warn,
+				Logger:             slog.New(handler),
+			})
+
+			var resp v1.PublishResponse
+			err := client.Publish(context.Background(), v1.PublishRequest{
+				Text:     "Signups this week",
+				Accounts: []string{"account-1"},
+				Media:    media,
+			}, &resp)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, logs.String())
+		})
+	}
+}
+
+func TestPublishRespectQuietHours(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	day := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	for _, test := range []struct {
+		name     string
+		now      time.Time
+		accounts []string
+		expected time.Time
+	}{
+		{
+			name:     "InsideQuietHours",
+			now:      day.Add(23*time.Hour + 30*time.Minute),
+			accounts: []string{"account-night"},
+			expected: day.AddDate(0, 0, 1).Add(7 * time.Hour),
+		},
+		{
+			name:     "AfterMidnight",
+			now:      day.Add(2 * time.Hour),
+			accounts: []string{"account-night"},
+			expected: day.Add(7 * time.Hour),
+		},
+		{
+			name:     "OverlappingAccounts",
+			now:      day.Add(2 * time.Hour),
+			accounts: []string{"account-night", "account-morning"},
+			expected: day.Add(9 * time.Hour),
+		},
+		{
+			name:     "OutsideQuietHours",
+			now:      day.Add(12 * time.Hour),
+			accounts: []string{"account-night", "account-morning"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.SetPersistCreatedPosts(true)
+			server.SetNow(func() time.Time { return test.now })
+			server.SetAccountSchedule("account-night", v1.QuietHours{Start: "22:00", End: "07:00"})
+			server.SetAccountSchedule("account-morning", v1.QuietHours{Start: "06:00", End: "09:00"})
+
+			var resp v1.PublishResponse
+			err := client.Publish(context.Background(), v1.PublishRequest{
+				Text:              "Good morning",
+				Accounts:          test.accounts,
+				RespectQuietHours: true,
+			}, &resp)
+			require.NoError(t, err)
+			assert.True(t, test.expected.Equal(resp.ScheduledAt))
+
+			posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+			require.NoError(t, err)
+			require.Len(t, posts, len(test.accounts))
+			for _, post := range posts {
+				if test.expected.IsZero() {
+					assert.Equal(t, v1.PostStatePublished, post.State)
+				} else {
+					assert.Equal(t, v1.PostStateScheduled, post.State)
+				}
+				assert.True(t, test.expected.Equal(post.ScheduledAt))
+			}
+		})
+	}
+}
+
+func TestScheduleRespectQuietHours(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	day := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return day }
+	client := server.ClientWithConfig(v1.Config{Now: clock})
+
+	server.Reset()
+	server.SetNow(clock)
+	server.SetAccountSchedule("account-1", v1.QuietHours{Start: "22:00", End: "07:00"})
+
+	var resp v1.ScheduleResponse
+	err := client.Schedule(context.Background(), v1.ScheduleRequest{
+		Text:              "Good morning",
+		Accounts:          []string{"account-1"},
+		ScheduledAt:       day.Add(3 * time.Hour),
+		RespectQuietHours: true,
+	}, &resp)
+	require.NoError(t, err)
+	assert.True(t, day.Add(7*time.Hour).Equal(resp.ResolvedTime))
+
+	err = client.Schedule(context.Background(), v1.ScheduleRequest{
+		Text:        "Night owl",
+		Accounts:    []string{"account-1"},
+		ScheduledAt: day.Add(3 * time.Hour),
+	}, &resp)
+	require.NoError(t, err)
+	assert.True(t, day.Add(3*time.Hour).Equal(resp.ResolvedTime))
+}
+
+func TestSchedulePostLink(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+
+	var resp v1.ScheduleResponse
+	err := client.Schedule(context.Background(), v1.ScheduleRequest{
+		ScheduledAt: time.Now().Add(time.Hour),
+		Text:        "Coming soon",
+		Link:        "https://example.com/preview",
+		Accounts:    []string{"account-1"},
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "Coming soon", posts[0].Text)
+	assert.Equal(t, "https://example.com/preview", posts[0].PostLink)
+}
+
+func TestSchedulePost(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	req := v1.ScheduleRequest{
+		ScheduledAt: time.Now().Add(time.Hour),
+		TimeZone:    "UTC",
+		Accounts:    []string{"account-1", "account-2"},
+		Text:        "Scheduled post content",
+	}
+
+	var resp v1.ScheduleResponse
+	server.Reset()
+
+	err := client.Schedule(context.Background(), req, &resp)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.JobID)
+
+	// Verify job status endpoint returns status for the created job
+	jobReq := v1.GetJobStatusRequest{JobID: resp.JobID}
+	var jobResp v1.GetJobStatusResponse
+	err = client.GetJobStatus(context.Background(), jobReq, &jobResp)
+	require.NoError(t, err)
+	assert.Equal(t, resp.JobID, jobResp.ID)
+	assert.Equal(t, "pending", jobResp.Status)
+	assert.Equal(t, 0, jobResp.Progress)
+}
+
+func TestSchedulePostValidation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name    string
+		request v1.ScheduleRequest
+		wantErr string
+	}{
+		{
+			name: "PastScheduledTime",
+			request: v1.ScheduleRequest{
+				ScheduledAt: time.Now().Add(-time.Hour),
+				Accounts:    []string{"account-1"},
+				Text:        "Test post",
+			},
+			wantErr: "scheduled time must be in the future",
+		},
+		{
+			name: "InvalidTimeZone",
+			request: v1.ScheduleRequest{
+				ScheduledAt: time.Now().Add(time.Hour),
+				TimeZone:    "Mars/Olympus_Mons",
+				Accounts:    []string{"account-1"},
+				Text:        "Test post",
+			},
+			wantErr: "Invalid time zone: Mars/Olympus_Mons",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+
+			var resp v1.ScheduleResponse
+			err := client.Schedule(context.Background(), test.request, &resp)
+			require.Error(t, err)
+			require.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
+
+func TestSchedulePostPinnedClock(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	client := server.ClientWithConfig(v1.Config{Now: clock})
+
+	for _, test := range []struct {
+		name        string
+		scheduledAt time.Time
+		wantErr     string
+	}{
+		{
+			name:        "OneSecondBefore",
+			scheduledAt: now.Add(-time.Second),
+			wantErr:     "scheduled time must be in the future",
+		},
+		{
+			name:        "ExactlyNow",
+			scheduledAt: now,
+			wantErr:     "scheduled time must be in the future",
+		},
+		{
+			name:        "OneSecondAfter",
+			scheduledAt: now.Add(time.Second),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.SetNow(clock)
+
+			var resp v1.ScheduleResponse
+			err := client.Schedule(context.Background(), v1.ScheduleRequest{
+				ScheduledAt: test.scheduledAt,
+				Accounts:    []string{"account-1"},
+				Text:        "Boundary post",
+			}, &resp)
+
+			if test.wantErr != "" {
+				require.Error(t, err)
+				require.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, resp.JobID)
+		})
+	}
+}
+
+func TestSchedulePostResolvedTimeZone(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	scheduledAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name         string
+		timeZone     string
+		wantTimeZone string
+		wantLocation *time.Location
+	}{
+		{
+			name:         "ExplicitTimeZone",
+			timeZone:     "America/New_York",
+			wantTimeZone: "America/New_York",
+			wantLocation: newYork,
+		},
+		{
+			name:         "DefaultTimeZone",
+			timeZone:     "",
+			wantTimeZone: "UTC",
+			wantLocation: time.UTC,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+
+			var resp v1.ScheduleResponse
+			err := client.Schedule(context.Background(), v1.ScheduleRequest{
+				ScheduledAt: scheduledAt,
+				TimeZone:    test.timeZone,
+				Accounts:    []string{"account-1"},
+				Text:        "Scheduled post content",
+			}, &resp)
+			require.NoError(t, err)
+
+			assert.NotEmpty(t, resp.JobID)
+			assert.Equal(t, test.wantTimeZone, resp.TimeZone)
+			assert.True(t, scheduledAt.Equal(resp.ResolvedTime))
+
+			_, wantOffset := scheduledAt.In(test.wantLocation).Zone()
+			_, gotOffset := resp.ResolvedTime.Zone()
+			assert.Equal(t, wantOffset, gotOffset)
+		})
+	}
+}
+
+func TestCreateDraftPost(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	req := v1.CreateDraftRequest{
+		Accounts:   []string{"account-1", "account-2"},
+		Visibility: "draft_private",
+		Text:       "Draft post content",
+	}
+
+	var resp v1.CreateDraftResponse
+	server.Reset()
+
+	err := client.CreateDraft(context.Background(), req, &resp)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.JobID)
+
+	// Verify job status endpoint returns status for the created job
+	jobReq := v1.GetJobStatusRequest(resp)
+	var jobResp v1.GetJobStatusResponse
+	err = client.GetJobStatus(context.Background(), jobReq, &jobResp)
+	require.NoError(t, err)
+	assert.Equal(t, resp.JobID, jobResp.ID)
+	assert.Equal(t, "pending", jobResp.Status)
+	assert.Equal(t, 0, jobResp.Progress)
+}
+
+func TestDraftVisibility(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name       string
+		visibility string
+		wantErr    string
+	}{
+		{
+			name:       "ValidPrivate",
+			visibility: "draft_private",
+			wantErr:    "",
+		},
+		{
+			name:       "ValidPublic",
+			visibility: "draft_public",
+			wantErr:    "",
+		},
+		{
+			name:       "InvalidVisibility",
+			visibility: "invalid_visibility",
+			wantErr:    "Invalid visibility. Must be draft_private or draft_public",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+
+			req := v1.CreateDraftRequest{
+				Visibility: test.visibility,
+				Accounts:   []string{"account-1"},
+				Text:       "Test draft",
+			}
+
+			var resp v1.CreateDraftResponse
+			err := client.CreateDraft(context.Background(), req, &resp)
+
+			if test.wantErr == "" {
+				require.NoError(t, err)
+				assert.NotEmpty(t, resp.JobID)
+			} else {
+				require.Error(t, err)
+				require.ErrorContains(t, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestWatchJob(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	const jobID = "test-job-watch"
+	server.Reset()
+	server.SetJobProgression(jobID, []v1.JobStatus{
+		{ID: jobID, Status: "pending", Progress: 0},
+		{ID: jobID, Status: "working", Progress: 50},
+		{ID: jobID, Status: "completed", Progress: 100, Result: &v1.JobResult{Success: true}},
+	})
+
+	statuses, errs := client.WatchJob(context.Background(), v1.WaitOptions{
+		JobID:        jobID,
+		InitialDelay: 10 * time.Millisecond,
+		MaxDelay:     20 * time.Millisecond,
+		Jitter:       5 * time.Millisecond,
+	})
+
+	var seen []string
+	for status := range statuses {
+		seen = append(seen, status.Status)
+		server.AdvanceJobState(jobID)
+	}
+	require.NoError(t, <-errs)
+	assert.Equal(t, []string{"pending", "working", "completed"}, seen)
+}
+
+func TestWatchJobFailed(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	const jobID = "test-job-watch-failed"
+	server.Reset()
+	server.SetJobStatus(jobID, "failed", 0, nil, "Processing failed")
+
+	statuses, errs := client.WatchJob(context.Background(), v1.WaitOptions{
+		JobID:        jobID,
+		InitialDelay: 10 * time.Millisecond,
+	})
+
+	var seen []string
+	for status := range statuses {
+		seen = append(seen, status.Status)
+	}
+	assert.Equal(t, []string{"failed"}, seen)
+	require.ErrorContains(t, <-errs, "job failed: Processing failed")
+}
+
+func TestWatchJobCancel(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	const jobID = "test-job-watch-cancel"
+	server.Reset()
+	server.SetJobStatus(jobID, "working", 50, nil, "")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	statuses, errs := client.WatchJob(ctx, v1.WaitOptions{
+		JobID:        jobID,
+		InitialDelay: 10 * time.Millisecond,
+		MaxDelay:     20 * time.Millisecond,
+		Jitter:       5 * time.Millisecond,
+	})
+
+	// Stop reading after the first update; the watcher must not block forever
+	status := <-statuses
+	assert.Equal(t, "working", status.Status)
+	cancel()
+
+	for range statuses {
+	}
+	assert.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestCheckDuplicate(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	server.Reset()
+	server.SetNow(func() time.Time { return now })
+	server.AddPosts([]v1.Post{
+		{ID: "post-01", AccountID: "acc-1", Text: "Big launch today!", CreatedAt: now.Add(-time.Hour)},
+		{ID: "post-02", AccountID: "acc-1", Text: "Old news", CreatedAt: now.Add(-72 * time.Hour)},
+	})
+
+	for _, test := range []struct {
+		name      string
+		accountID string
+		text      string
+		expected  bool
+	}{
+		{
+			name:      "RecentMatch",
+			accountID: "acc-1",
+			text:      "Big launch today!",
+			expected:  true,
+		},
+		{
+			name:      "DifferentText",
+			accountID: "acc-1",
+			text:      "Something new",
+			expected:  false,
+		},
+		{
+			name:      "DifferentAccount",
+			accountID: "acc-2",
+			text:      "Big launch today!",
+			expected:  false,
+		},
+		{
+			name:      "OutsideWindow",
+			accountID: "acc-1",
+			text:      "Old news",
+			expected:  false,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			duplicate, err := client.CheckDuplicate(context.Background(), test.accountID, test.text)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, duplicate)
+		})
+	}
+}
+
+func TestCheckDuplicateValidation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	_, err := client.CheckDuplicate(context.Background(), "", "text")
+	require.EqualError(t, err, "account ID cannot be empty")
+
+	_, err = client.CheckDuplicate(context.Background(), "acc-1", "  ")
+	require.EqualError(t, err, "text cannot be empty")
+}
+
+func TestPublishPostThread(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+	server.AddAccount(v1.Account{ID: "acc-twitter", Provider: "twitter"})
+
+	// Text is the first part, Thread holds the replies that follow it
+	var resp v1.PublishResponse
+	err := client.Publish(context.Background(), v1.PublishRequest{
+		Text:     "1/ We shipped threads",
+		Thread:   []string{"2/ Each part is a reply", "3/ That's it"},
+		Accounts: []string{"acc-twitter"},
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "1/ We shipped threads", posts[0].Text)
+	assert.Equal(t, []string{"2/ Each part is a reply", "3/ That's it"}, posts[0].Thread)
+}
+
+func TestSchedulePostThread(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+	server.AddAccount(v1.Account{ID: "acc-threads", Provider: "threads"})
+
+	var resp v1.ScheduleResponse
+	err := client.Schedule(context.Background(), v1.ScheduleRequest{
+		Text:        "Part one",
+		Thread:      []string{"Part two", "Part three"},
+		Accounts:    []string{"acc-threads"},
+		ScheduledAt: time.Now().Add(time.Hour),
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, []string{"Part two", "Part three"}, posts[0].Thread)
+}
+
+func TestPublishPostThreadValidation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name        string
+		accounts    []string
+		thread      []string
+		expectedErr string
+	}{
+		{
+			name:        "UnsupportedNetwork",
+			accounts:    []string{"acc-linkedin"},
+			thread:      []string{"Part two"},
+			expectedErr: "Threads are not supported for linkedin",
+		},
+		{
+			name:        "EmptyPart",
+			accounts:    []string{"acc-twitter"},
+			thread:      []string{"Part two", " "},
+			expectedErr: "thread part 2 cannot be empty",
+		},
+		{
+			name:     "MixedNetworks",
+			accounts: []string{"acc-twitter", "acc-linkedin"},
+			thread:   []string{"Part two"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.AddAccount(v1.Account{ID: "acc-twitter", Provider: "twitter"})
+			server.AddAccount(v1.Account{ID: "acc-linkedin", Provider: "linkedin"})
+
+			var resp v1.PublishResponse
+			err := client.Publish(context.Background(), v1.PublishRequest{
+				Text:     "Part one",
+				Thread:   test.thread,
+				Accounts: test.accounts,
+			}, &resp)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestPublishPostPoll(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+	server.AddAccount(v1.Account{ID: "acc-twitter", Provider: "twitter"})
+
+	var resp v1.PublishResponse
+	err := client.Publish(context.Background(), v1.PublishRequest{
+		Text:     "Which release should we ship next?",
+		Accounts: []string{"acc-twitter"},
+		Poll: &v1.Poll{
+			Options:  []string{"Threads", "Polls", "Both"},
+			Duration: 24 * time.Hour,
+		},
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	require.NotNil(t, posts[0].Poll)
+	assert.Equal(t, []string{"Threads", "Polls", "Both"}, posts[0].Poll.Options)
+	assert.Equal(t, 24*time.Hour, posts[0].Poll.Duration)
+}
+
+func TestPostPollValidation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name        string
+		poll        *v1.Poll
+		expectedErr string
+	}{
+		{
+			name:        "TooFewOptions",
+			poll:        &v1.Poll{Options: []string{"Yes"}, Duration: time.Hour},
+			expectedErr: "poll must have between 2 and 4 options, got 1",
+		},
+		{
+			name:        "TooManyOptions",
+			poll:        &v1.Poll{Options: []string{"A", "B", "C", "D", "E"}, Duration: time.Hour},
+			expectedErr: "poll must have between 2 and 4 options, got 5",
+		},
+		{
+			name:        "EmptyOption",
+			poll:        &v1.Poll{Options: []string{"Yes", " "}, Duration: time.Hour},
+			expectedErr: "poll option 2 cannot be empty",
+		},
+		{
+			name:        "NoDuration",
+			poll:        &v1.Poll{Options: []string{"Yes", "No"}},
+			expectedErr: "poll duration must be positive",
+		},
+		{
+			name: "Valid",
+			poll: &v1.Poll{Options: []string{"Yes", "No"}, Duration: time.Hour},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+			server.AddAccount(v1.Account{ID: "acc-twitter", Provider: "twitter"})
+
+			var publishResp v1.PublishResponse
+			err := client.Publish(context.Background(), v1.PublishRequest{
+				Text:     "Poll question",
+				Accounts: []string{"acc-twitter"},
+				Poll:     test.poll,
+			}, &publishResp)
+
+			var scheduleResp v1.ScheduleResponse
+			scheduleErr := client.Schedule(context.Background(), v1.ScheduleRequest{
+				Text:        "Poll question",
+				Accounts:    []string{"acc-twitter"},
+				ScheduledAt: time.Now().Add(time.Hour),
+				Poll:        test.poll,
+			}, &scheduleResp)
+
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+				require.NoError(t, scheduleErr)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+			require.Error(t, scheduleErr)
+			assert.Contains(t, scheduleErr.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestWaitForPosts(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+	server.AddAccount(v1.Account{ID: "acc-1", Provider: "twitter"})
+	server.AddAccount(v1.Account{ID: "acc-2", Provider: "facebook"})
+	server.AddAccount(v1.Account{ID: "acc-3", Provider: "linkedin"})
+
+	var resp v1.PublishResponse
+	err := client.Publish(context.Background(), v1.PublishRequest{
+		Text:     "Hello everyone",
+		Accounts: []string{"acc-1", "acc-2", "acc-3"},
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.WaitForPosts(context.Background(), v1.WaitOptions{
+		JobID:        resp.JobID,
+		InitialDelay: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Len(t, posts, 3)
+	for i, accountID := range []string{"acc-1", "acc-2", "acc-3"} {
+		assert.Equal(t, accountID, posts[i].AccountID)
+		assert.Equal(t, "Hello everyone", posts[i].Text)
+		assert.Equal(t, "published", posts[i].State)
+	}
+}
+
+func TestWaitForPostsFailedJob(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	const jobID = "test-job-posts-failed"
+	server.Reset()
+	server.SetJobStatus(jobID, "failed", 0, nil, "Processing failed")
+
+	posts, err := client.WaitForPosts(context.Background(), v1.WaitOptions{
+		JobID:        jobID,
+		InitialDelay: 10 * time.Millisecond,
+	})
+	require.ErrorContains(t, err, "job failed: Processing failed")
+	assert.Nil(t, posts)
+}
+
+func TestWaitForPostsMissingPost(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	const jobID = "test-job-posts-missing"
+	server.Reset()
+	server.AddPosts([]v1.Post{{ID: "post-01", Text: "Exists"}})
+	server.SetJobStatus(jobID, "completed", 100, &v1.JobResult{
+		Success: true,
+		PostIDs: []string{"post-01", "post-02"},
+	}, "")
+
+	posts, err := client.WaitForPosts(context.Background(), v1.WaitOptions{
+		JobID:        jobID,
+		InitialDelay: 10 * time.Millisecond,
+	})
+	require.ErrorContains(t, err, "post post-02")
+	assert.Nil(t, posts)
+}
+
+func TestSchedulePostAuto(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	redact := false
+	var requestBody string
+	client := server.ClientWithConfig(v1.Config{
+		RedactBodies: &redact,
+		OnResponse: func(info v1.ResponseInfo) {
+			requestBody = info.RequestBody
+		},
+	})
+
+	now := time.Date(2025, 6, 1, 12, 34, 0, 0, time.UTC)
+	server.Reset()
+	server.SetNow(func() time.Time { return now })
+	server.SetPersistCreatedPosts(true)
+
+	var resp v1.ScheduleResponse
+	err := client.Schedule(context.Background(), v1.ScheduleRequest{
+		Auto:     true,
+		Accounts: []string{"account-1"},
+		Text:     "Whenever works best",
+	}, &resp)
+	require.NoError(t, err)
+	assert.Contains(t, requestBody, `"scheduled_at":"auto"`)
+
+	expected := time.Date(2025, 6, 1, 13, 0, 0, 0, time.UTC)
+	assert.True(t, expected.Equal(resp.ResolvedTime))
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.True(t, expected.Equal(posts[0].ScheduledAt))
+}
+
+func TestSchedulePostAutoWithScheduledAt(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	var resp v1.ScheduleResponse
+	err := client.Schedule(context.Background(), v1.ScheduleRequest{
+		Auto:        true,
+		ScheduledAt: time.Now().Add(time.Hour),
+		Accounts:    []string{"account-1"},
+		Text:        "Conflicting times",
+	}, &resp)
+	require.EqualError(t, err, "scheduled time cannot be set when auto scheduling")
+}
+
+func TestSchedulePostSkipInactiveAccounts(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+	server.AddAccounts([]v1.Account{
+		{ID: "acc-001", Provider: "twitter", State: v1.AccountStateActive},
+		{ID: "acc-002", Provider: "facebook", State: v1.AccountStateExpired},
+		{ID: "acc-003", Provider: "linkedin"},
+		{ID: "acc-004", Provider: "instagram", State: v1.AccountStateExpired},
+	})
+
+	var resp v1.ScheduleResponse
+	err := client.Schedule(context.Background(), v1.ScheduleRequest{
+		Text:                 "Skip disconnected accounts",
+		Accounts:             []string{"acc-001", "acc-002", "acc-003", "acc-004"},
+		ScheduledAt:          time.Now().Add(time.Hour),
+		SkipInactiveAccounts: true,
+	}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"acc-002", "acc-004"}, resp.SkippedAccounts)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+
+	var accountIDs []string
+	for _, post := range posts {
+		accountIDs = append(accountIDs, post.AccountID)
+	}
+	assert.ElementsMatch(t, []string{"acc-001", "acc-003"}, accountIDs)
+
+	// Scheduling fails when every account is inactive
+	resp = v1.ScheduleResponse{}
+	err = client.Schedule(context.Background(), v1.ScheduleRequest{
+		Text:                 "Nothing to post to",
+		Accounts:             []string{"acc-002", "acc-004"},
+		ScheduledAt:          time.Now().Add(time.Hour),
+		SkipInactiveAccounts: true,
+	}, &resp)
+	require.EqualError(t, err, "no active accounts to schedule to: skipped acc-002, acc-004")
+	assert.Empty(t, resp.JobID)
+}
+
+func TestSchedulePostExpiresAt(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+	server.AddAccount(v1.Account{ID: "acc-001", Provider: "facebook"})
+
+	scheduledAt := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	expiresAt := scheduledAt.Add(48 * time.Hour)
+
+	var resp v1.ScheduleResponse
+	err := client.Schedule(context.Background(), v1.ScheduleRequest{
+		Text:        "Flash sale ends Sunday",
+		Accounts:    []string{"acc-001"},
+		ScheduledAt: scheduledAt,
+		ExpiresAt:   expiresAt,
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.True(t, expiresAt.Equal(posts[0].ExpiresAt))
+}
+
+func TestPostExpiresAtValidation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+	server.Reset()
+
+	scheduledAt := time.Now().Add(time.Hour)
+
+	for _, test := range []struct {
+		name        string
+		req         v1.ScheduleRequest
+		expectedErr string
+	}{
+		{
+			name:        "BeforeScheduledAt",
+			req:         v1.ScheduleRequest{ScheduledAt: scheduledAt, ExpiresAt: scheduledAt.Add(-time.Minute)},
+			expectedErr: "expiration must be after the scheduled time",
+		},
+		{
+			name:        "EqualToScheduledAt",
+			req:         v1.ScheduleRequest{ScheduledAt: scheduledAt, ExpiresAt: scheduledAt},
+			expectedErr: "expiration must be after the scheduled time",
+		},
+		{
+			name:        "AutoInPast",
+			req:         v1.ScheduleRequest{Auto: true, ExpiresAt: time.Now().Add(-time.Minute)},
+			expectedErr: "expiration must be in the future",
+		},
+		{
+			name: "AfterScheduledAt",
+			req:  v1.ScheduleRequest{ScheduledAt: scheduledAt, ExpiresAt: scheduledAt.Add(time.Hour)},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			test.req.Text = "Expiring post"
+			test.req.Accounts = []string{"acc-001"}
+
+			var resp v1.ScheduleResponse
+			err := client.Schedule(context.Background(), test.req, &resp)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, test.expectedErr)
+		})
+	}
+
+	var resp v1.PublishResponse
+	err := client.Publish(context.Background(), v1.PublishRequest{
+		Text:      "Expiring post",
+		Accounts:  []string{"acc-001"},
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}, &resp)
+	require.EqualError(t, err, "expiration must be in the future")
+}
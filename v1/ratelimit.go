@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimitState is the rate limit budget last reported by the API
+type RateLimitState struct {
+	Remaining int       // requests left in the current window
+	Reset     time.Time // when the window resets; zero when unknown
+}
+
+// RateLimitStore holds the rate limit state shared by clients. Clients configured
+// with the same store wait for the window to reset once any of them learns the
+// budget is exhausted, so a store backed by Redis or similar coordinates several
+// processes. Implementations must be safe for concurrent use.
+type RateLimitStore interface {
+	// Get returns the last state set, or the zero state when none is known
+	Get(ctx context.Context) (RateLimitState, error)
+	// Set records the state reported by the most recent response
+	Set(ctx context.Context, state RateLimitState) error
+}
+
+// MemoryRateLimitStore is a RateLimitStore for clients in a single process
+type MemoryRateLimitStore struct {
+	mu    sync.Mutex
+	state RateLimitState
+}
+
+// NewMemoryRateLimitStore returns an empty in-memory RateLimitStore
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{}
+}
+
+// Get returns the last state set
+func (s *MemoryRateLimitStore) Get(ctx context.Context) (RateLimitState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state, nil
+}
+
+// Set records the state
+func (s *MemoryRateLimitStore) Set(ctx context.Context, state RateLimitState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+	return nil
+}
+
+// RateLimitConfig bounds how many requests a client sends per window
+type RateLimitConfig struct {
+	Requests int           // requests allowed in any window; 0 disables the limiter
+	Window   time.Duration // length of the window, e.g. 2 minutes for Publer
+}
+
+// tokenBucket holds up to limit tokens, one spent per request. A spent token is
+// returned one window after it was spent, so no span of that length ever sees more
+// than limit requests, even across the server's window boundaries. A nil bucket
+// never blocks.
+type tokenBucket struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	spent  []time.Time // when each outstanding token was spent, oldest first
+}
+
+// newTokenBucket returns a full bucket for config, or nil when config disables limiting
+func newTokenBucket(config RateLimitConfig) *tokenBucket {
+	if config.Requests <= 0 || config.Window <= 0 {
+		return nil
+	}
+	return &tokenBucket{limit: config.Requests, window: config.Window}
+}
+
+// refill returns the tokens spent at least a window before now. Callers must hold b.mu.
+func (b *tokenBucket) refill(now time.Time) {
+	n := 0
+	for n < len(b.spent) && !now.Before(b.spent[n].Add(b.window)) {
+		n++
+	}
+	b.spent = b.spent[n:]
+}
+
+// wait spends a token, blocking until one is returned when the bucket is empty. It
+// returns ctx.Err() if ctx is done first.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		b.mu.Lock()
+		now := time.Now()
+		b.refill(now)
+		if len(b.spent) < b.limit {
+			b.spent = append(b.spent, now)
+			b.mu.Unlock()
+			return nil
+		}
+		delay := b.spent[0].Add(b.window).Sub(now)
+		b.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// state reports the tokens left and when the oldest spent token is returned
+func (b *tokenBucket) state() RateLimitState {
+	if b == nil {
+		return RateLimitState{Remaining: -1}
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.refill(now)
+	state := RateLimitState{Remaining: b.limit - len(b.spent)}
+	if len(b.spent) > 0 {
+		state.Reset = b.spent[0].Add(b.window)
+	}
+	return state
+}
@@ -0,0 +1,197 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/thrawn/publer.go/v1"
+)
+
+// fakeRateLimitStore records every state set so tests can inspect what clients shared
+type fakeRateLimitStore struct {
+	mu    sync.Mutex
+	state v1.RateLimitState
+	sets  []v1.RateLimitState
+}
+
+func (s *fakeRateLimitStore) Get(ctx context.Context) (v1.RateLimitState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state, nil
+}
+
+func (s *fakeRateLimitStore) Set(ctx context.Context, state v1.RateLimitState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+	s.sets = append(s.sets, state)
+	return nil
+}
+
+func TestRateLimitStoreSharedBetweenClients(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	server.Reset()
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+	server.SetRateLimit(100, 0)
+	server.SetRateLimitReset(reset)
+
+	store := &fakeRateLimitStore{}
+	first := server.ClientWithConfig(v1.Config{RateLimitStore: store})
+	second := server.ClientWithConfig(v1.Config{RateLimitStore: store})
+
+	// The first client learns the budget is exhausted and shares it
+	_, err := first.ListAccountsPage(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, store.sets, 1)
+	assert.Equal(t, 0, store.sets[0].Remaining)
+	assert.True(t, reset.Equal(store.sets[0].Reset))
+
+	// The second client waits for the reset instead of sending
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = second.ListAccountsPage(ctx, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, server.WorkspaceHeaders(), 1)
+}
+
+func TestRateLimitStoreWaitsForReset(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	server.Reset()
+	server.SetRateLimit(100, 99)
+
+	store := v1.NewMemoryRateLimitStore()
+	require.NoError(t, store.Set(context.Background(), v1.RateLimitState{
+		Remaining: 0,
+		Reset:     time.Now().Add(100 * time.Millisecond),
+	}))
+	client := server.ClientWithConfig(v1.Config{RateLimitStore: store})
+
+	start := time.Now()
+	_, err := client.ListAccountsPage(context.Background(), 1)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// The response refreshed the shared state, so the next request is not delayed
+	state, err := store.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 99, state.Remaining)
+
+	start = time.Now()
+	_, err = client.ListAccountsPage(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimitStoreDefault(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	server.Reset()
+	server.SetRateLimit(100, 0)
+	server.SetRateLimitReset(time.Now().Add(time.Hour))
+
+	// Without a configured store the client still honors the budget it was told about
+	client := server.Client()
+	_, err := client.ListAccountsPage(context.Background(), 1)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.ListAccountsPage(ctx, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, server.WorkspaceHeaders(), 1)
+}
+
+func TestRateLimitStoreUsesConfigNow(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	server.Reset()
+
+	store := v1.NewMemoryRateLimitStore()
+	require.NoError(t, store.Set(context.Background(), v1.RateLimitState{
+		Remaining: 0,
+		Reset:     time.Now().Add(time.Hour),
+	}))
+
+	// The client's clock is past the reset, so the request is sent right away
+	client := server.ClientWithConfig(v1.Config{
+		RateLimitStore: store,
+		Now:            func() time.Time { return time.Now().Add(2 * time.Hour) },
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err := client.ListAccountsPage(ctx, 1)
+	require.NoError(t, err)
+}
+
+func TestRateLimitConcurrentCalls(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	server.Reset()
+	server.SetRequestLimit(100, time.Second)
+
+	// A slightly longer window on the client absorbs the delay between the client
+	// spending a token and the server seeing the request
+	client := server.ClientWithConfig(v1.Config{
+		RateLimit: v1.RateLimitConfig{Requests: 100, Window: 1100 * time.Millisecond},
+	})
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	errs := make([]error, 120)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = client.ListAccountsPage(context.Background(), 1)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		var rateLimitErr *v1.RateLimitError
+		assert.False(t, errors.As(err, &rateLimitErr), "call %d was rate limited", i+1)
+		assert.NoError(t, err)
+	}
+	assert.Len(t, server.WorkspaceHeaders(), 120)
+
+	// The calls beyond the first 100 waited for tokens to be returned
+	assert.GreaterOrEqual(t, time.Since(start), 1100*time.Millisecond)
+}
+
+func TestRateLimitBlocksUntilContextDone(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	server.Reset()
+	client := server.ClientWithConfig(v1.Config{
+		RateLimit: v1.RateLimitConfig{Requests: 1, Window: time.Hour},
+	})
+
+	_, err := client.ListAccountsPage(context.Background(), 1)
+	require.NoError(t, err)
+
+	state := client.RateLimitState()
+	assert.Equal(t, 0, state.Remaining)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), state.Reset, time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.ListAccountsPage(ctx, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, server.WorkspaceHeaders(), 1)
+
+	// Without a limit configured the state reports no budget
+	assert.Equal(t, -1, server.Client().RateLimitState().Remaining)
+}
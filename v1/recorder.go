@@ -0,0 +1,170 @@
+package v1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// Interaction is a recorded request and the response it received. Requests are
+// identified by method, path with query and a hash of the body; request headers,
+// including the API key, are never recorded.
+type Interaction struct {
+	Method     string      `json:"method"`
+	Path       string      `json:"path"`
+	BodyHash   string      `json:"body_hash"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// key identifies the request an interaction answers
+func (i Interaction) key() string {
+	return i.Method + " " + i.Path + " " + i.BodyHash
+}
+
+// RecordingTransport is an http.RoundTripper that forwards requests to another
+// transport and writes every interaction to a JSON file, which a ReplayTransport
+// can later serve without network access. Use it as the transport of Config.Client.
+type RecordingTransport struct {
+	next         http.RoundTripper
+	path         string
+	mu           sync.Mutex
+	interactions []Interaction
+}
+
+// NewRecordingTransport returns a transport that records to the file at path. The
+// file is rewritten after each interaction. A nil next uses http.DefaultTransport.
+func NewRecordingTransport(path string, next http.RoundTripper) *RecordingTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &RecordingTransport{next: next, path: path}
+}
+
+// RoundTrip sends the request and records the response
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	interaction, err := newInteraction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	interaction.StatusCode = resp.StatusCode
+	interaction.Header = resp.Header.Clone()
+	interaction.Body = string(body)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.interactions = append(t.interactions, interaction)
+	if err := t.save(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// save writes all interactions recorded so far to the file
+func (t *RecordingTransport) save() error {
+	encoded, err := json.MarshalIndent(t.interactions, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode interactions: %w", err)
+	}
+	if err := os.WriteFile(t.path, encoded, 0o644); err != nil {
+		return fmt.Errorf("failed to write interactions: %w", err)
+	}
+	return nil
+}
+
+// ReplayTransport is an http.RoundTripper that answers requests from interactions
+// recorded by a RecordingTransport. Requests with the same key are answered in the
+// order they were recorded; a request with no remaining interaction fails.
+type ReplayTransport struct {
+	mu           sync.Mutex
+	interactions map[string][]Interaction
+}
+
+// NewReplayTransport loads the interactions recorded in the file at path
+func NewReplayTransport(path string) (*ReplayTransport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read interactions: %w", err)
+	}
+	var recorded []Interaction
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		return nil, fmt.Errorf("failed to decode interactions: %w", err)
+	}
+
+	t := &ReplayTransport{interactions: make(map[string][]Interaction)}
+	for _, interaction := range recorded {
+		key := interaction.key()
+		t.interactions[key] = append(t.interactions[key], interaction)
+	}
+	return t, nil
+}
+
+// RoundTrip returns the next recorded response for the request
+func (t *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	match, err := newInteraction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	queue := t.interactions[match.key()]
+	if len(queue) == 0 {
+		return nil, fmt.Errorf("no recorded interaction for %s %s", match.Method, match.Path)
+	}
+	interaction := queue[0]
+	t.interactions[match.key()] = queue[1:]
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        interaction.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader([]byte(interaction.Body))),
+		ContentLength: int64(len(interaction.Body)),
+		Request:       req,
+	}, nil
+}
+
+// newInteraction returns an interaction holding the key of req. The request body is
+// read to hash it and replaced so it can still be sent.
+func newInteraction(req *http.Request) (Interaction, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return Interaction{}, fmt.Errorf("failed to read request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	sum := sha256.Sum256(body)
+	return Interaction{
+		Method:   req.Method,
+		Path:     req.URL.RequestURI(),
+		BodyHash: hex.EncodeToString(sum[:]),
+	}, nil
+}
@@ -0,0 +1,74 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/thrawn/publer.go/v1"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "interactions.json")
+
+	// Record against the mock server
+	server := v1.SpawnMockServer()
+	server.Reset()
+	server.SetCurrentUser(v1.User{ID: "user-001", Email: "user@example.com", Name: "Test User"})
+	server.AddAccounts([]v1.Account{{ID: "acc-001", Provider: "twitter", Name: "Twitter"}})
+
+	recorder := v1.NewRecordingTransport(path, nil)
+	client := server.ClientWithConfig(v1.Config{Client: &http.Client{Transport: recorder}})
+
+	var me v1.GetMeResponse
+	require.NoError(t, client.GetMe(context.Background(), v1.GetMeRequest{}, &me))
+
+	var publishResp v1.PublishResponse
+	require.NoError(t, client.Publish(context.Background(), v1.PublishRequest{
+		Text:     "Recorded post",
+		Accounts: []string{"acc-001"},
+	}, &publishResp))
+
+	_, err := client.GetPostAnalytics(context.Background(), "missing-1")
+	require.Error(t, err)
+	require.NoError(t, server.Stop())
+
+	// Replay without the mock server
+	replay, err := v1.NewReplayTransport(path)
+	require.NoError(t, err)
+	client, err = v1.NewClient(v1.Config{
+		APIKey:      "replay-key",
+		WorkspaceID: "replay-workspace",
+		BaseURL:     "http://publer.invalid/api/v1/",
+		Client:      &http.Client{Transport: replay},
+	})
+	require.NoError(t, err)
+
+	var replayedMe v1.GetMeResponse
+	require.NoError(t, client.GetMe(context.Background(), v1.GetMeRequest{}, &replayedMe))
+	assert.Equal(t, me, replayedMe)
+
+	var replayedPublish v1.PublishResponse
+	require.NoError(t, client.Publish(context.Background(), v1.PublishRequest{
+		Text:     "Recorded post",
+		Accounts: []string{"acc-001"},
+	}, &replayedPublish))
+	assert.Equal(t, publishResp.JobID, replayedPublish.JobID)
+
+	_, err = client.GetPostAnalytics(context.Background(), "missing-1")
+	assert.ErrorIs(t, err, v1.ErrNotFound)
+
+	// A request with a different body was never recorded
+	err = client.Publish(context.Background(), v1.PublishRequest{
+		Text:     "Different post",
+		Accounts: []string{"acc-001"},
+	}, &replayedPublish)
+	require.ErrorContains(t, err, "no recorded interaction for POST /api/v1/posts/schedule/publish")
+
+	// Each recorded interaction is served once
+	err = client.GetMe(context.Background(), v1.GetMeRequest{}, &replayedMe)
+	require.ErrorContains(t, err, "no recorded interaction for GET /api/v1/users/me")
+}
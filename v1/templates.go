@@ -0,0 +1,28 @@
+package v1
+
+// CreateTemplateRequest represents a new saved post template
+type CreateTemplateRequest struct {
+	Name   string   `json:"name"`
+	Text   string   `json:"text"`
+	Media  []Media  `json:"media,omitempty"`
+	Labels []string `json:"labels,omitempty"`
+}
+
+// CreateTemplateResponse contains the created template
+type CreateTemplateResponse struct {
+	Template
+}
+
+// GetTemplateResponse represents single template response
+type GetTemplateResponse struct {
+	Template
+}
+
+// ListTemplatesResponse represents paginated template list response
+type ListTemplatesResponse struct {
+	Templates  []Template `json:"templates"`
+	Total      int        `json:"total"`
+	Page       int        `json:"page"`
+	PerPage    int        `json:"per_page"`
+	TotalPages int        `json:"total_pages"`
+}
@@ -0,0 +1,124 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/thrawn/publer.go/v1"
+)
+
+func TestTemplates(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddTemplate(v1.Template{ID: "template-001", Name: "Sign-off", Text: "Thanks for reading!"})
+
+	var created v1.CreateTemplateResponse
+	err := client.CreateTemplate(context.Background(), v1.CreateTemplateRequest{
+		Name:   "Launch hashtags",
+		Text:   "#launch #publer",
+		Labels: []string{"marketing"},
+	}, &created)
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, "Launch hashtags", created.Name)
+	assert.Equal(t, []string{"marketing"}, created.Labels)
+
+	var template v1.GetTemplateResponse
+	err = client.GetTemplate(context.Background(), created.ID, &template)
+	require.NoError(t, err)
+	assert.Equal(t, "#launch #publer", template.Text)
+
+	templates, err := v1.Collect(context.Background(), client.ListTemplates(context.Background()))
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, "template-001", templates[0].ID)
+	assert.Equal(t, created.ID, templates[1].ID)
+
+	err = client.DeleteTemplate(context.Background(), "template-001")
+	require.NoError(t, err)
+
+	templates, err = v1.Collect(context.Background(), client.ListTemplates(context.Background()))
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	assert.Equal(t, created.ID, templates[0].ID)
+
+	err = client.DeleteTemplate(context.Background(), "template-001")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, v1.ErrNotFound)
+}
+
+func TestCreateTemplateValidation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name        string
+		req         v1.CreateTemplateRequest
+		expectedErr string
+	}{
+		{
+			name:        "MissingName",
+			req:         v1.CreateTemplateRequest{Text: "Thanks for reading!"},
+			expectedErr: "template name is required",
+		},
+		{
+			name:        "MissingText",
+			req:         v1.CreateTemplateRequest{Name: "Sign-off"},
+			expectedErr: "template text is required",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+
+			var resp v1.CreateTemplateResponse
+			err := client.CreateTemplate(context.Background(), test.req, &resp)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+
+	err := client.DeleteTemplate(context.Background(), "../admin")
+	require.Error(t, err)
+	require.ErrorContains(t, err, "invalid template ID")
+}
+
+func TestPublishFromTemplate(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+	server.AddAccount(v1.Account{ID: "acc-twitter", Provider: "twitter"})
+	server.AddTemplate(v1.Template{
+		ID:     "template-001",
+		Name:   "Weekly update",
+		Text:   "Here is what shipped this week",
+		Media:  []v1.Media{{URL: "https://example.com/banner.jpg", Type: "image"}},
+		Labels: []string{"weekly"},
+	})
+
+	err := client.PublishFromTemplate(context.Background(), "template-001", []string{"acc-twitter"})
+	require.NoError(t, err)
+
+	page, err := client.ListPostsPage(context.Background(), v1.ListPostsRequest{}, 1)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, "acc-twitter", page.Items[0].AccountID)
+	assert.Equal(t, "Here is what shipped this week", page.Items[0].Text)
+	assert.Equal(t, []v1.Media{{URL: "https://example.com/banner.jpg", Type: "image"}}, page.Items[0].Media)
+	assert.Equal(t, []string{"weekly"}, page.Items[0].Labels)
+
+	err = client.PublishFromTemplate(context.Background(), "template-999", []string{"acc-twitter"})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, v1.ErrNotFound)
+}
@@ -1,6 +1,76 @@
 package v1
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PostStateTrashed is the state of a deleted post that is still held in the trash
+const PostStateTrashed = "trashed"
+
+// Post states used when changing a post's lifecycle
+const (
+	PostStateDraft     = "draft"
+	PostStateScheduled = "scheduled"
+	PostStatePublished = "published"
+)
+
+// PostStatePendingApproval is the state of a post waiting for a reviewer
+const PostStatePendingApproval = "pending_approval"
+
+// Plan features reported by Client.Features
+const (
+	FeatureRecurringPosts = "recurring_posts"
+	FeatureAutoSchedule   = "auto_schedule"
+	FeatureRecycling      = "recycling"
+	FeatureApprovals      = "approvals"
+)
+
+// Post kinds distinguish where on a network a post appears
+const (
+	PostKindFeed  = "feed"
+	PostKindStory = "story"
+	PostKindReel  = "reel"
+	PostKindShort = "short"
+)
+
+// postKindsByNetwork lists the post kinds each network accepts
+var postKindsByNetwork = map[string][]string{
+	"facebook":  {PostKindFeed, PostKindStory, PostKindReel},
+	"instagram": {PostKindFeed, PostKindStory, PostKindReel},
+	"youtube":   {PostKindFeed, PostKindShort},
+	"tiktok":    {PostKindFeed},
+	"twitter":   {PostKindFeed},
+	"linkedin":  {PostKindFeed},
+	"pinterest": {PostKindFeed},
+}
+
+// networkSupportsPostKind reports whether the network accepts the post kind.
+// Networks without known restrictions accept every kind.
+func networkSupportsPostKind(network, kind string) bool {
+	kinds, ok := postKindsByNetwork[network]
+	if !ok {
+		return true
+	}
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// threadNetworks lists the networks that accept multi-part thread posts
+var threadNetworks = map[string]bool{
+	"twitter": true,
+	"threads": true,
+}
+
+// networkSupportsThreads reports whether the network accepts multi-part thread posts
+func networkSupportsThreads(network string) bool {
+	return threadNetworks[network]
+}
 
 // User represents a Publer user
 type User struct {
@@ -21,11 +91,46 @@ type Post struct {
 	AccountID   string    `json:"account_id"`
 	User        User      `json:"user"`
 	ScheduledAt time.Time `json:"scheduled_at"`
+	CreatedAt   time.Time `json:"created_at"`
 	PostLink    string    `json:"post_link"`
 	HasMedia    bool      `json:"has_media"`
+	Media       []Media   `json:"media,omitempty"`
 	Network     string    `json:"network"`
+	Labels      []string  `json:"labels,omitempty"`
+	PostKind    string    `json:"post_kind,omitempty"`
+	Thread      []string  `json:"thread,omitempty"`
+	Pinned      bool      `json:"pinned,omitempty"`
+	Poll        *Poll     `json:"poll,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at,omitzero"` // when the post is deleted from the network, if set
+	Thumbnail   string    `json:"thumbnail,omitempty"` // preview image URL, e.g. for link cards
 }
 
+// UnmarshalJSON decodes a post, falling back to the URL of its first media item
+// when the API has not generated a thumbnail
+func (p *Post) UnmarshalJSON(data []byte) error {
+	type plain Post
+	if err := json.Unmarshal(data, (*plain)(p)); err != nil {
+		return err
+	}
+	if p.Thumbnail == "" && len(p.Media) > 0 {
+		p.Thumbnail = p.Media[0].URL
+	}
+	return nil
+}
+
+// Series is a recurring post series along with the posts generated for it
+type Series struct {
+	ID          string `json:"id"`
+	Status      string `json:"status"` // SeriesStatusActive or SeriesStatusCompleted
+	Occurrences []Post `json:"occurrences"`
+}
+
+// Recurring series statuses
+const (
+	SeriesStatusActive    = "active"
+	SeriesStatusCompleted = "completed"
+)
+
 // Account represents a social media account
 type Account struct {
 	ID       string `json:"id"`
@@ -34,8 +139,24 @@ type Account struct {
 	SocialID string `json:"social_id"`
 	Picture  string `json:"picture"`
 	Type     string `json:"type"`
+
+	// Permissions the current user holds on this account, e.g. AccountPermissionPost
+	Permissions []string `json:"permissions,omitempty"`
+
+	// State is the connection state, AccountStateActive or AccountStateExpired.
+	// Expired accounts must be reconnected before they can post again.
+	State string `json:"state,omitempty"`
 }
 
+// Account connection states
+const (
+	AccountStateActive  = "active"
+	AccountStateExpired = "expired"
+)
+
+// AccountPermissionPost allows the current user to publish and schedule posts on an account
+const AccountPermissionPost = "post"
+
 // Workspace represents a Publer workspace
 type Workspace struct {
 	ID      string `json:"id"`
@@ -46,6 +167,22 @@ type Workspace struct {
 	Picture string `json:"picture"`
 }
 
+// NetworkInfo describes the capabilities and limits of a social network
+type NetworkInfo struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	CharLimit  int      `json:"char_limit"`
+	MediaTypes []string `json:"media_types"`
+	MaxMedia   int      `json:"max_media"`
+}
+
+// WorkspaceStats contains aggregate post counts for a workspace
+type WorkspaceStats struct {
+	Total     int            `json:"total"`
+	ByState   map[string]int `json:"by_state"`
+	ByNetwork map[string]int `json:"by_network"`
+}
+
 // JobStatus represents async job status (basic definition, extended in Phase 1)
 type JobStatus struct {
 	ID       string     `json:"id"`
@@ -64,8 +201,123 @@ type JobResult struct {
 	Data    map[string]interface{} `json:"data,omitempty"`
 }
 
+// ItemError describes the failure of one post in a bulk operation
+type ItemError struct {
+	PostIndex int    `json:"post_index"` // zero based index of the post in the request
+	Error     string `json:"error"`
+}
+
+// ItemErrors decodes the per-post failures reported in Data["errors"] by partially
+// failed bulk jobs. It returns nil when the result has no errors entry.
+func (r JobResult) ItemErrors() ([]ItemError, error) {
+	raw, ok := r.Data["errors"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode job errors: %w", err)
+	}
+	var items []ItemError
+	if err := json.Unmarshal(encoded, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode job errors: %w", err)
+	}
+	return items, nil
+}
+
+// Comment represents a reply left on a published post
+type Comment struct {
+	ID        string    `json:"id"`
+	Author    string    `json:"author"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+	ParentID  string    `json:"parent_id,omitempty"` // set on replies to another comment
+}
+
+// TimeSlot is a recommended weekly posting time for an account
+type TimeSlot struct {
+	Weekday time.Weekday `json:"weekday"` // 0 is Sunday
+	Time    string       `json:"time"`    // "15:04" in the account's timezone
+	Score   float64      `json:"score"`   // relative engagement score, higher is better
+}
+
+// QuietHours is a daily window during which an account should not publish. A window
+// whose End is before Start spans midnight.
+type QuietHours struct {
+	Start string `json:"start"` // "15:04" in UTC
+	End   string `json:"end"`   // "15:04" in UTC
+}
+
+// Poll limits enforced before a poll is sent
+const (
+	minPollOptions = 2
+	maxPollOptions = 4
+)
+
+// Poll is a question with fixed answers attached to a post on networks that support
+// polls, such as Twitter and Facebook
+type Poll struct {
+	Options  []string
+	Duration time.Duration // how long the poll accepts votes, sent in whole seconds
+}
+
+// pollJSON is the wire format of Poll
+type pollJSON struct {
+	Options         []string `json:"options"`
+	DurationSeconds int64    `json:"duration_seconds"`
+}
+
+// MarshalJSON sends the duration as whole seconds
+func (p Poll) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pollJSON{
+		Options:         p.Options,
+		DurationSeconds: int64(p.Duration / time.Second),
+	})
+}
+
+// UnmarshalJSON reads the duration from whole seconds
+func (p *Poll) UnmarshalJSON(data []byte) error {
+	var aux pollJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	p.Options = aux.Options
+	p.Duration = time.Duration(aux.DurationSeconds) * time.Second
+	return nil
+}
+
+// PostAnalytics contains engagement metrics for a published post
+type PostAnalytics struct {
+	Impressions int `json:"impressions"`
+	Reach       int `json:"reach"`
+	Likes       int `json:"likes"`
+	Comments    int `json:"comments"`
+	Shares      int `json:"shares"`
+	Clicks      int `json:"clicks"`
+}
+
+// Template is saved post content, such as a sign-off or hashtag set, reused when
+// creating posts
+type Template struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Text   string   `json:"text"`
+	Media  []Media  `json:"media,omitempty"`
+	Labels []string `json:"labels,omitempty"`
+}
+
+// Webhook is a subscription delivering events to an application endpoint
+type Webhook struct {
+	ID     string   `json:"id"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Secret string   `json:"secret,omitempty"` // signs deliveries so they can be verified
+}
+
 // Media represents media attachment
 type Media struct {
-	URL  string `json:"url"`
-	Type string `json:"type"`
+	URL     string `json:"url"`
+	Type    string `json:"type"`
+	AltText string `json:"alt_text,omitempty"` // describes an image for screen readers
 }
\ No newline at end of file
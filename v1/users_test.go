@@ -3,6 +3,7 @@ package v1_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -54,3 +55,68 @@ func TestGetMeNotFound(t *testing.T) {
 	assert.Equal(t, 404, apiErr.StatusCode)
 }
 
+func TestGetMeResponseMeta(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	t.Run("Success", func(t *testing.T) {
+		server.Reset()
+		server.SetCurrentUser(v1.User{ID: "user-123"})
+		server.SetRateLimit(100, 42)
+
+		var meta v1.ResponseMeta
+		var resp v1.GetMeResponse
+		err := client.GetMe(v1.WithResponseMeta(context.Background(), &meta), v1.GetMeRequest{}, &resp)
+		require.NoError(t, err)
+		assert.Equal(t, "user-123", resp.ID)
+		assert.Equal(t, 200, meta.StatusCode)
+		assert.Equal(t, "42", meta.Header.Get("X-RateLimit-Remaining"))
+		assert.Equal(t, "100", meta.Header.Get("X-RateLimit-Limit"))
+	})
+
+	t.Run("ErrorResponse", func(t *testing.T) {
+		server.Reset()
+		server.SetRateLimit(100, 7)
+
+		var meta v1.ResponseMeta
+		var resp v1.GetMeResponse
+		err := client.GetMe(v1.WithResponseMeta(context.Background(), &meta), v1.GetMeRequest{}, &resp)
+		require.Error(t, err)
+		assert.Equal(t, 404, meta.StatusCode)
+		assert.Equal(t, "7", meta.Header.Get("X-RateLimit-Remaining"))
+	})
+}
+
+func TestDailyPostBudget(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	resets := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	server.Reset()
+	server.SetDailyUsage(7, 10, resets)
+
+	used, limit, resetsAt, err := client.DailyPostBudget(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 7, used)
+	assert.Equal(t, 10, limit)
+	assert.True(t, resets.Equal(resetsAt))
+}
+
+func TestDailyPostBudgetUncapped(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	used, limit, resetsAt, err := client.DailyPostBudget(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, used)
+	assert.Equal(t, 0, limit)
+	assert.True(t, resetsAt.IsZero())
+}
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// UTMParams are the campaign tracking parameters appended to links in a post. Empty
+// fields are left out.
+type UTMParams struct {
+	Source   string // utm_source, e.g. "twitter"
+	Medium   string // utm_medium, e.g. "social"
+	Campaign string // utm_campaign
+	Term     string // utm_term
+	Content  string // utm_content
+}
+
+// textURLRegex matches http and https URLs in post text
+var textURLRegex = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+// AppendUTM returns rawURL with the non-empty UTM parameters added to its query.
+// The existing query is kept as written, so signed or order-sensitive links still
+// work, except that UTM parameters being set replace any already present. The
+// fragment is kept.
+func AppendUTM(rawURL string, p UTMParams) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %w", rawURL, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid URL %q: must be absolute", rawURL)
+	}
+
+	var params []string
+	replaced := make(map[string]bool)
+	for _, param := range []struct{ name, value string }{
+		{"utm_source", p.Source},
+		{"utm_medium", p.Medium},
+		{"utm_campaign", p.Campaign},
+		{"utm_term", p.Term},
+		{"utm_content", p.Content},
+	} {
+		if param.value != "" {
+			params = append(params, param.name+"="+url.QueryEscape(param.value))
+			replaced[param.name] = true
+		}
+	}
+	if len(params) == 0 {
+		return rawURL, nil
+	}
+
+	var kept []string
+	if u.RawQuery != "" {
+		for _, pair := range strings.Split(u.RawQuery, "&") {
+			name, _, _ := strings.Cut(pair, "=")
+			if unescaped, err := url.QueryUnescape(name); err == nil && replaced[unescaped] {
+				continue
+			}
+			kept = append(kept, pair)
+		}
+	}
+	u.RawQuery = strings.Join(append(kept, params...), "&")
+	u.ForceQuery = false
+	return u.String(), nil
+}
+
+// appendUTMToText adds the UTM parameters to every URL in text. Punctuation ending a
+// sentence right after a URL is not treated as part of it.
+func appendUTMToText(text string, p UTMParams) (string, error) {
+	var firstErr error
+	tagged := textURLRegex.ReplaceAllStringFunc(text, func(match string) string {
+		link := strings.TrimRight(match, ".,;:!?)]}'")
+		withUTM, err := AppendUTM(link, p)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			return match
+		}
+		return withUTM + match[len(link):]
+	})
+	return tagged, firstErr
+}
+
+// appendPostUTM adds the UTM parameters to the URLs in the text, link, variants and
+// thread of a post in place. The variants map and thread slice are replaced by
+// copies so the caller's request is left untouched.
+func appendPostUTM(p UTMParams, text, link *string, variants *map[string]string, thread *[]string) error {
+	var err error
+	if *text, err = appendUTMToText(*text, p); err != nil {
+		return err
+	}
+	if *link != "" {
+		if *link, err = AppendUTM(*link, p); err != nil {
+			return err
+		}
+	}
+	if *variants != nil {
+		tagged := make(map[string]string, len(*variants))
+		for network, variant := range *variants {
+			if tagged[network], err = appendUTMToText(variant, p); err != nil {
+				return err
+			}
+		}
+		*variants = tagged
+	}
+	if *thread != nil {
+		tagged := make([]string, len(*thread))
+		for i, part := range *thread {
+			if tagged[i], err = appendUTMToText(part, p); err != nil {
+				return err
+			}
+		}
+		*thread = tagged
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/thrawn/publer.go/v1"
+)
+
+func TestAppendUTM(t *testing.T) {
+	params := v1.UTMParams{Source: "twitter", Medium: "social", Campaign: "spring launch"}
+
+	for _, test := range []struct {
+		name        string
+		url         string
+		params      v1.UTMParams
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "NoQuery",
+			url:      "https://example.com/launch",
+			params:   params,
+			expected: "https://example.com/launch?utm_source=twitter&utm_medium=social&utm_campaign=spring+launch",
+		},
+		{
+			name:     "ExistingQuery",
+			url:      "https://example.com/launch?ref=home&page=2",
+			params:   params,
+			expected: "https://example.com/launch?ref=home&page=2&utm_source=twitter&utm_medium=social&utm_campaign=spring+launch",
+		},
+		{
+			name:     "SignedQueryKeptAsWritten",
+			url:      "https://example.com/file?name=spring%20sale&sig=AbC%2B1&expires=1700000000",
+			params:   v1.UTMParams{Source: "twitter"},
+			expected: "https://example.com/file?name=spring%20sale&sig=AbC%2B1&expires=1700000000&utm_source=twitter",
+		},
+		{
+			name:     "Fragment",
+			url:      "https://example.com/launch?ref=home#pricing",
+			params:   v1.UTMParams{Source: "newsletter"},
+			expected: "https://example.com/launch?ref=home&utm_source=newsletter#pricing",
+		},
+		{
+			name:     "ReplacesExistingUTM",
+			url:      "https://example.com/?utm_source=facebook&utm_term=shoes",
+			params:   v1.UTMParams{Source: "twitter", Content: "banner"},
+			expected: "https://example.com/?utm_term=shoes&utm_source=twitter&utm_content=banner",
+		},
+		{
+			name:        "Relative",
+			url:         "/launch",
+			params:      params,
+			expectedErr: `invalid URL "/launch": must be absolute`,
+		},
+		{
+			name:        "Malformed",
+			url:         "https://exa mple.com/%zz",
+			params:      params,
+			expectedErr: `invalid URL "https://exa mple.com/%zz"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := v1.AppendUTM(test.url, test.params)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestPublishUTM(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.ClientWithConfig(v1.Config{DryRun: true})
+
+	variants := map[string]string{"twitter": "Short version https://example.com/a?x=1"}
+	thread := []string{"Details at https://example.com/docs#setup."}
+	err := client.Publish(context.Background(), v1.PublishRequest{
+		Text:     "Launch day! See https://example.com/launch, or (https://example.com/faq).",
+		Link:     "https://example.com/launch#top",
+		Variants: variants,
+		Thread:   thread,
+		Accounts: []string{"account-1"},
+		UTM:      &v1.UTMParams{Source: "publer", Campaign: "launch"},
+	}, &v1.PublishResponse{})
+	require.NoError(t, err)
+
+	requests := client.DryRunRequests()
+	require.Len(t, requests, 1)
+	var sent struct {
+		Text     string            `json:"text"`
+		Link     string            `json:"link"`
+		Variants map[string]string `json:"variants"`
+		Thread   []string          `json:"thread"`
+		UTM      any               `json:"utm"`
+	}
+	require.NoError(t, json.Unmarshal(requests[0].Body, &sent))
+	assert.Equal(t, "Launch day! See https://example.com/launch?utm_source=publer&utm_campaign=launch, "+
+		"or (https://example.com/faq?utm_source=publer&utm_campaign=launch).", sent.Text)
+	assert.Equal(t, "https://example.com/launch?utm_source=publer&utm_campaign=launch#top", sent.Link)
+	assert.Equal(t, "Short version https://example.com/a?x=1&utm_source=publer&utm_campaign=launch", sent.Variants["twitter"])
+	assert.Equal(t, []string{"Details at https://example.com/docs?utm_source=publer&utm_campaign=launch#setup."}, sent.Thread)
+	assert.Nil(t, sent.UTM)
+
+	// The caller's variants and thread are left untouched
+	assert.Equal(t, "Short version https://example.com/a?x=1", variants["twitter"])
+	assert.Equal(t, "Details at https://example.com/docs#setup.", thread[0])
+}
+
+func TestScheduleUTM(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.SetPersistCreatedPosts(true)
+
+	var resp v1.ScheduleResponse
+	err := client.Schedule(context.Background(), v1.ScheduleRequest{
+		ScheduledAt: time.Now().Add(time.Hour),
+		Text:        "Coming soon",
+		Link:        "https://example.com/preview?ref=feed",
+		Accounts:    []string{"account-1"},
+		UTM:         &v1.UTMParams{Source: "publer", Medium: "social"},
+	}, &resp)
+	require.NoError(t, err)
+
+	posts, err := client.BulkResultPosts(context.Background(), resp.JobID)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "https://example.com/preview?ref=feed&utm_source=publer&utm_medium=social", posts[0].PostLink)
+
+	err = client.Schedule(context.Background(), v1.ScheduleRequest{
+		ScheduledAt: time.Now().Add(time.Hour),
+		Text:        "Coming soon",
+		Link:        "example.com/preview",
+		Accounts:    []string{"account-1"},
+		UTM:         &v1.UTMParams{Source: "publer"},
+	}, &resp)
+	require.ErrorContains(t, err, "must be absolute")
+}
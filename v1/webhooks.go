@@ -0,0 +1,22 @@
+package v1
+
+// CreateWebhookRequest represents a new webhook subscription
+type CreateWebhookRequest struct {
+	URL    string   `json:"url"`    // must use https
+	Events []string `json:"events"` // event names to deliver, e.g. "post.published"
+}
+
+// CreateWebhookResponse contains the created subscription, including the secret used
+// to sign deliveries
+type CreateWebhookResponse struct {
+	Webhook
+}
+
+// ListWebhooksResponse represents paginated webhook subscription list response
+type ListWebhooksResponse struct {
+	Webhooks   []Webhook `json:"webhooks"`
+	Total      int       `json:"total"`
+	Page       int       `json:"page"`
+	PerPage    int       `json:"per_page"`
+	TotalPages int       `json:"total_pages"`
+}
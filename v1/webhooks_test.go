@@ -0,0 +1,92 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/thrawn/publer.go/v1"
+)
+
+func TestWebhooks(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	var created v1.CreateWebhookResponse
+	err := client.CreateWebhook(context.Background(), v1.CreateWebhookRequest{
+		URL:    "https://example.com/hooks/publer",
+		Events: []string{"post.published", "post.failed"},
+	}, &created)
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.NotEmpty(t, created.Secret)
+	assert.Equal(t, "https://example.com/hooks/publer", created.URL)
+	assert.Equal(t, []string{"post.published", "post.failed"}, created.Events)
+
+	webhooks, err := v1.Collect(context.Background(), client.ListWebhooks(context.Background()))
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, created.ID, webhooks[0].ID)
+
+	err = client.DeleteWebhook(context.Background(), created.ID)
+	require.NoError(t, err)
+
+	webhooks, err = v1.Collect(context.Background(), client.ListWebhooks(context.Background()))
+	require.NoError(t, err)
+	assert.Empty(t, webhooks)
+
+	err = client.DeleteWebhook(context.Background(), created.ID)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, v1.ErrNotFound)
+}
+
+func TestCreateWebhookValidation(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	for _, test := range []struct {
+		name        string
+		req         v1.CreateWebhookRequest
+		expectedErr string
+	}{
+		{
+			name:        "HTTP",
+			req:         v1.CreateWebhookRequest{URL: "http://example.com/hooks", Events: []string{"post.published"}},
+			expectedErr: "webhook URL must use https",
+		},
+		{
+			name:        "NoHost",
+			req:         v1.CreateWebhookRequest{URL: "/hooks", Events: []string{"post.published"}},
+			expectedErr: `invalid webhook URL "/hooks"`,
+		},
+		{
+			name:        "NoEvents",
+			req:         v1.CreateWebhookRequest{URL: "https://example.com/hooks"},
+			expectedErr: "at least one webhook event is required",
+		},
+		{
+			name:        "EmptyEvent",
+			req:         v1.CreateWebhookRequest{URL: "https://example.com/hooks", Events: []string{"post.published", ""}},
+			expectedErr: "webhook event 2 cannot be empty",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server.Reset()
+
+			var resp v1.CreateWebhookResponse
+			err := client.CreateWebhook(context.Background(), test.req, &resp)
+			require.EqualError(t, err, test.expectedErr)
+		})
+	}
+
+	err := client.DeleteWebhook(context.Background(), "../admin")
+	require.Error(t, err)
+	require.ErrorContains(t, err, "invalid webhook ID")
+}
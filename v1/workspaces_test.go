@@ -2,6 +2,8 @@ package v1_test
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -163,4 +165,319 @@ func TestListWorkspacesEmpty(t *testing.T) {
 	assert.Equal(t, 0, page.TotalPages)
 	assert.Len(t, page.Items, 0)
 	assert.False(t, hasMore)
-}
\ No newline at end of file
+}
+
+func TestListWorkspacesPage(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	var workspaces []v1.Workspace
+	for i := 1; i <= 15; i++ {
+		workspaces = append(workspaces, v1.Workspace{
+			ID:   fmt.Sprintf("workspace-%02d", i),
+			Name: fmt.Sprintf("Workspace %d", i),
+		})
+	}
+
+	server.Reset()
+	server.AddWorkspaces(workspaces)
+
+	page, err := client.ListWorkspacesPage(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, 15, page.Total)
+	assert.Equal(t, 2, page.Page)
+	assert.Equal(t, 2, page.TotalPages)
+	require.Len(t, page.Items, 5)
+	assert.Equal(t, "workspace-11", page.Items[0].ID)
+	assert.Equal(t, "workspace-15", page.Items[4].ID)
+}
+
+func TestWorkspaceStats(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddPosts([]v1.Post{
+		{ID: "s1", State: "published", Network: "twitter"},
+		{ID: "s2", State: "published", Network: "facebook"},
+		{ID: "s3", State: "scheduled", Network: "twitter"},
+		{ID: "s4", State: "draft", Network: "linkedin"},
+		{ID: "s5", State: "scheduled", Network: "twitter"},
+	})
+
+	stats, err := client.WorkspaceStats(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, stats.Total)
+	assert.Equal(t, map[string]int{"published": 2, "scheduled": 2, "draft": 1}, stats.ByState)
+	assert.Equal(t, map[string]int{"twitter": 3, "facebook": 1, "linkedin": 1}, stats.ByNetwork)
+}
+
+func TestWorkspaceStatsEmpty(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	stats, err := client.WorkspaceStats(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, stats.Total)
+	assert.Empty(t, stats.ByState)
+	assert.Empty(t, stats.ByNetwork)
+}
+
+func TestListPostsInWorkspace(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddWorkspaces([]v1.Workspace{{ID: "workspace-a"}, {ID: "workspace-b"}})
+	server.AddPosts([]v1.Post{{ID: "post-01", State: "scheduled"}})
+
+	ctx := context.Background()
+	for _, workspaceID := range []string{"workspace-a", "workspace-b"} {
+		it := client.ListPostsInWorkspace(ctx, workspaceID, v1.ListPostsRequest{})
+		var page v1.Page[v1.Post]
+		it.Next(ctx, &page)
+		require.NoError(t, it.Err())
+		assert.Len(t, page.Items, 1)
+	}
+
+	// The client's own workspace is unchanged afterwards
+	_, err := client.ListAccountsPage(ctx, 1)
+	require.NoError(t, err)
+
+	headers := server.WorkspaceHeaders()
+	require.Len(t, headers, 3)
+	assert.Equal(t, "workspace-a", headers[0])
+	assert.Equal(t, "workspace-b", headers[1])
+	assert.Equal(t, server.WorkspaceID(), headers[2])
+}
+
+func TestListAccountsInWorkspace(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddWorkspaces([]v1.Workspace{{ID: "workspace-a"}})
+	server.AddAccount(v1.Account{ID: "acc-1", Provider: "twitter"})
+
+	ctx := context.Background()
+	it := client.ListAccountsInWorkspace(ctx, "workspace-a", v1.ListAccountsRequest{})
+	var page v1.Page[v1.Account]
+	it.Next(ctx, &page)
+	require.NoError(t, it.Err())
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, []string{"workspace-a"}, server.WorkspaceHeaders())
+}
+
+func TestListPostsInWorkspaceInvalidID(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	for _, test := range []struct {
+		name        string
+		workspaceID string
+		expectedErr string
+	}{
+		{
+			name:        "Empty",
+			workspaceID: "",
+			expectedErr: "invalid workspace ID: workspace ID cannot be empty",
+		},
+		{
+			name:        "HeaderInjection",
+			workspaceID: "ws\r\nX-Evil: 1",
+			expectedErr: "invalid workspace ID: workspace ID must contain only alphanumeric characters, hyphens, and underscores",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			it := client.ListPostsInWorkspace(ctx, test.workspaceID, v1.ListPostsRequest{})
+			var page v1.Page[v1.Post]
+			assert.False(t, it.Next(ctx, &page))
+			require.Error(t, it.Err())
+			assert.Equal(t, test.expectedErr, it.Err().Error())
+		})
+	}
+	assert.Empty(t, server.WorkspaceHeaders())
+}
+
+func TestListPostsInWorkspaceUnknown(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+
+	ctx := context.Background()
+	it := client.ListPostsInWorkspace(ctx, "workspace-unknown", v1.ListPostsRequest{})
+	var page v1.Page[v1.Post]
+	assert.False(t, it.Next(ctx, &page))
+	require.Error(t, it.Err())
+	assert.Contains(t, it.Err().Error(), "Missing or invalid workspace ID")
+}
+
+func TestWithWorkspace(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddWorkspaces([]v1.Workspace{{ID: "workspace-a"}, {ID: "workspace-b"}})
+	server.AddPosts([]v1.Post{{ID: "post-01", State: "scheduled"}})
+
+	scopedA := client.WithWorkspace("workspace-a")
+	scopedB := client.WithWorkspace("workspace-b")
+
+	// Fan out across both workspaces at once
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, scoped := range []*v1.Client{scopedA, scopedB} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = v1.Collect(ctx, scoped.ListPosts(ctx, v1.ListPostsRequest{}))
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	assert.ElementsMatch(t, []string{"workspace-a", "workspace-b"}, server.WorkspaceHeaders())
+
+	// The original client keeps its own workspace
+	_, err := client.ListAccountsPage(ctx, 1)
+	require.NoError(t, err)
+	headers := server.WorkspaceHeaders()
+	require.Len(t, headers, 3)
+	assert.Equal(t, server.WorkspaceID(), headers[2])
+
+	for _, test := range []struct {
+		name        string
+		workspaceID string
+		expectedErr string
+	}{
+		{
+			name:        "Empty",
+			workspaceID: "",
+			expectedErr: "invalid workspace ID: workspace ID cannot be empty",
+		},
+		{
+			name:        "HeaderInjection",
+			workspaceID: "ws\r\nX-Evil: 1",
+			expectedErr: "invalid workspace ID: workspace ID must contain only alphanumeric characters, hyphens, and underscores",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			scoped := client.WithWorkspace(test.workspaceID)
+			_, err := scoped.ListAccountsPage(ctx, 1)
+			require.EqualError(t, err, test.expectedErr)
+			assert.Len(t, server.WorkspaceHeaders(), 3)
+		})
+	}
+}
+
+func TestMovePostToWorkspace(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddWorkspaces([]v1.Workspace{{ID: "workspace-b"}})
+	server.AddPosts([]v1.Post{
+		{ID: "post-01", State: "scheduled"},
+		{ID: "post-02", State: "scheduled"},
+	})
+
+	ctx := context.Background()
+	err := client.MovePostToWorkspace(ctx, "post-01", "workspace-b")
+	require.NoError(t, err)
+
+	// The post is gone from the source workspace
+	page, err := client.ListPostsPage(ctx, v1.ListPostsRequest{}, 1)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, "post-02", page.Items[0].ID)
+
+	var resp v1.GetPostResponse
+	err = client.GetPost(ctx, v1.GetPostRequest{PostID: "post-01"}, &resp)
+	assert.ErrorIs(t, err, v1.ErrNotFound)
+
+	// And listed in the target workspace
+	posts, err := v1.Collect(ctx, client.ListPostsInWorkspace(ctx, "workspace-b", v1.ListPostsRequest{}))
+	require.NoError(t, err)
+	var ids []string
+	for _, post := range posts {
+		ids = append(ids, post.ID)
+	}
+	assert.Contains(t, ids, "post-01")
+}
+
+func TestMovePostToWorkspaceErrors(t *testing.T) {
+	server := v1.SpawnMockServer()
+	defer func() { _ = server.Stop() }()
+
+	client := server.Client()
+
+	server.Reset()
+	server.AddWorkspaces([]v1.Workspace{{ID: "workspace-b"}})
+	server.AddPosts([]v1.Post{{ID: "post-01", State: "scheduled"}})
+
+	ctx := context.Background()
+	for _, test := range []struct {
+		name        string
+		postID      string
+		workspaceID string
+		expectedErr string
+	}{
+		{
+			name:        "InvalidPostID",
+			postID:      "../admin",
+			workspaceID: "workspace-b",
+			expectedErr: "invalid post ID",
+		},
+		{
+			name:        "InvalidWorkspaceID",
+			postID:      "post-01",
+			workspaceID: "workspace b",
+			expectedErr: "invalid workspace ID",
+		},
+		{
+			name:        "UnknownWorkspace",
+			postID:      "post-01",
+			workspaceID: "workspace-z",
+			expectedErr: "Workspace not found",
+		},
+		{
+			name:        "UnknownPost",
+			postID:      "post-99",
+			workspaceID: "workspace-b",
+			expectedErr: "Post not found",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := client.MovePostToWorkspace(ctx, test.postID, test.workspaceID)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}